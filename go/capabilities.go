@@ -0,0 +1,183 @@
+package pintui
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+// ColorMode controls whether pintui emits ANSI color codes.
+type ColorMode int
+
+const (
+	// ColorAuto detects color support from the environment (the default).
+	ColorAuto ColorMode = iota
+	// ColorAlways forces color output regardless of environment.
+	ColorAlways
+	// ColorNever disables color output regardless of environment.
+	ColorNever
+)
+
+// UnicodeMode controls whether pintui emits Unicode glyphs or plain ASCII
+// fallbacks for icons and drawing characters.
+type UnicodeMode int
+
+const (
+	// UnicodeAuto detects Unicode support from the environment (the default).
+	UnicodeAuto UnicodeMode = iota
+	// UnicodeASCII forces ASCII fallbacks regardless of environment.
+	UnicodeASCII
+	// UnicodeUnicode forces Unicode glyphs regardless of environment.
+	UnicodeUnicode
+)
+
+var caps = &capabilityState{}
+
+type capabilityState struct {
+	mu          sync.Mutex
+	colorMode   ColorMode
+	unicodeMode UnicodeMode
+}
+
+func init() {
+	applyColorMode(ColorAuto)
+}
+
+// SetColorMode overrides pintui's automatic color detection.
+//
+// Example:
+//
+//	pintui.SetColorMode(pintui.ColorNever) // plain output, e.g. for log files
+func SetColorMode(mode ColorMode) {
+	caps.mu.Lock()
+	caps.colorMode = mode
+	caps.mu.Unlock()
+	applyColorMode(mode)
+}
+
+// SetUnicodeMode overrides pintui's automatic Unicode-glyph detection.
+//
+// Example:
+//
+//	pintui.SetUnicodeMode(pintui.UnicodeASCII) // "[OK]" instead of "✓"
+func SetUnicodeMode(mode UnicodeMode) {
+	caps.mu.Lock()
+	caps.unicodeMode = mode
+	caps.mu.Unlock()
+}
+
+// applyColorMode updates the global color.NoColor flag that fatih/color
+// consults on every Sprint/Println call, so the effect is immediate and not
+// frozen at package init.
+func applyColorMode(mode ColorMode) {
+	switch mode {
+	case ColorAlways:
+		color.NoColor = false
+	case ColorNever:
+		color.NoColor = true
+	default:
+		color.NoColor = !detectColorSupport()
+	}
+}
+
+// detectColorSupport implements the NO_COLOR / FORCE_COLOR / CLICOLOR(_FORCE)
+// / TERM=dumb / CI heuristics used by ColorAuto.
+func detectColorSupport() bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	if v, ok := os.LookupEnv("FORCE_COLOR"); ok && v != "0" {
+		return true
+	}
+	if v, ok := os.LookupEnv("CLICOLOR_FORCE"); ok && v != "0" {
+		return true
+	}
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	if term.IsTerminal(int(os.Stdout.Fd())) {
+		if v, ok := os.LookupEnv("CLICOLOR"); ok && v == "0" {
+			return false
+		}
+		return true
+	}
+	// Not a TTY: most CI log viewers still render ANSI color fine.
+	return isCI()
+}
+
+// isCI reports whether common CI environment variables are set.
+func isCI() bool {
+	for _, name := range []string{"CI", "GITHUB_ACTIONS", "BUILDKITE"} {
+		if os.Getenv(name) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// unicodeEnabled reports whether icons and drawing characters should use
+// Unicode glyphs, consulting SetUnicodeMode and then the environment.
+func unicodeEnabled() bool {
+	caps.mu.Lock()
+	mode := caps.unicodeMode
+	caps.mu.Unlock()
+
+	switch mode {
+	case UnicodeASCII:
+		return false
+	case UnicodeUnicode:
+		return true
+	default:
+		return detectUnicodeSupport()
+	}
+}
+
+func detectUnicodeSupport() bool {
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	lang := strings.ToUpper(os.Getenv("LC_ALL") + os.Getenv("LC_CTYPE") + os.Getenv("LANG"))
+	if lang != "" && !strings.Contains(lang, "UTF-8") && !strings.Contains(lang, "UTF8") {
+		return false
+	}
+	return true
+}
+
+// icon returns the styled icon for a message kind ("info", "success",
+// "warn", "error"), sourced from the active Theme and falling back to an
+// ASCII equivalent when unicodeEnabled() is false.
+func icon(kind string) string {
+	glyph, ascii, colorName := iconGlyphs(kind)
+	colorFn := colorByName(colorName)
+	if unicodeEnabled() {
+		return colorFn(glyph)
+	}
+	return colorFn(ascii)
+}
+
+func iconGlyphs(kind string) (glyph, ascii, colorName string) {
+	t := currentTheme()
+	switch kind {
+	case "success":
+		return t.Icons.Success, "[OK]", t.Colors.Success
+	case "warn":
+		return t.Icons.Warn, "[!]", t.Colors.Warn
+	case "error":
+		return t.Icons.Error, "[X]", t.Colors.Error
+	default: // "info"
+		return t.Icons.Info, "[i]", t.Colors.Info
+	}
+}
+
+// dividerRune returns the rune pintui repeats to draw dividers and header
+// underlines, sourced from the active Theme and falling back to "-" when
+// unicodeEnabled() is false.
+func dividerRune() string {
+	if unicodeEnabled() {
+		return currentTheme().Divider
+	}
+	return "-"
+}
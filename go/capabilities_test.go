@@ -0,0 +1,47 @@
+package pintui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func resetCaps() {
+	caps.mu.Lock()
+	caps.colorMode = ColorAuto
+	caps.unicodeMode = UnicodeAuto
+	caps.mu.Unlock()
+	applyColorMode(ColorAuto)
+}
+
+func TestSetColorModeForcesGlobalFlag(t *testing.T) {
+	defer resetCaps()
+
+	SetColorMode(ColorAlways)
+	if color.NoColor {
+		t.Error("color.NoColor = true, want false after SetColorMode(ColorAlways)")
+	}
+
+	SetColorMode(ColorNever)
+	if !color.NoColor {
+		t.Error("color.NoColor = false, want true after SetColorMode(ColorNever)")
+	}
+}
+
+func TestSetUnicodeModeASCIIFallback(t *testing.T) {
+	defer resetCaps()
+
+	SetUnicodeMode(UnicodeASCII)
+	if got := icon("success"); !strings.Contains(got, "[OK]") {
+		t.Errorf("icon(success) = %q, want it to contain [OK]", got)
+	}
+	if got := dividerRune(); got != "-" {
+		t.Errorf("dividerRune() = %q, want \"-\"", got)
+	}
+
+	SetUnicodeMode(UnicodeUnicode)
+	if got := icon("success"); !strings.Contains(got, "✓") {
+		t.Errorf("icon(success) = %q, want it to contain ✓", got)
+	}
+}
@@ -42,9 +42,15 @@
 // # Modules
 //
 //   - Messages: Info, Success, Warn, Error, Dim
-//   - Layout: Header, Section, KV, Step, Blank, Divider, Indent
-//   - Progress: Spinner, Bar, StageProgress
+//   - Layout: Header, Section, KV, Step, Blank, Divider, Indent, Writer, PanicPolicy
+//   - Progress: Spinner, Bar, StageProgress, MultiProgress
 //   - Format: HumanSize, ParseSize, TruncatePath, Pluralize, HumanDuration
+//   - SizeFormatter: FormatSize, NewSizeFormatter, UnitsIEC, UnitsSI
+//   - Logging: SetLevel, SetOutput, SetFormat, WithFields
+//   - Capabilities: SetColorMode, SetUnicodeMode (NO_COLOR/FORCE_COLOR/CI aware)
+//   - Theme: LoadTheme, LoadThemeFS, DefaultTheme, MonochromeTheme, RegisterTheme
+//   - Prompts: Confirm, Input, Password, Select, MultiSelect
+//   - Table: NewTable, Row, SetAlignment, SetStyle, Print
 //
 // For more information, see https://github.com/albertocavalcante/pintui
 package pintui
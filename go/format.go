@@ -12,12 +12,19 @@ const (
 	MB uint64 = KB * 1024
 	GB uint64 = MB * 1024
 	TB uint64 = GB * 1024
+	PB uint64 = TB * 1024
+	EB uint64 = PB * 1024
 )
 
 // HumanSize formats bytes as a human-readable size string.
 //
 // Automatically selects the appropriate unit (B, KB, MB, GB, TB)
-// based on the magnitude of the value.
+// based on the magnitude of the value. It predates SizeFormatter and is
+// frozen at its historical output for compatibility: 2 decimal digits at
+// the TB tier (every smaller tier uses 1) and no PB/EB tiers, so it caps
+// out at TB rather than continuing to scale. New code that wants PB/EB or
+// uniform precision should use FormatSize/SizeFormatter with UnitsCompat
+// instead.
 //
 // Example:
 //
@@ -43,7 +50,8 @@ func HumanSize(bytes uint64) string {
 
 // ParseSize parses a human-readable size string into bytes.
 //
-// Supports suffixes: B, KB, MB, GB, TB (case-insensitive).
+// Supports suffixes: B, KB, MB, GB, TB, the IEC variants KiB, MiB, GiB, TiB,
+// and bare K, M, G, T shorthand (all case-insensitive, all 1024-based).
 // Numbers without suffixes are treated as bytes.
 // Decimal values are supported (e.g., "1.5GB").
 //
@@ -51,6 +59,8 @@ func HumanSize(bytes uint64) string {
 //
 //	pintui.ParseSize("100")      // 100, nil
 //	pintui.ParseSize("1KB")      // 1024, nil
+//	pintui.ParseSize("1KiB")     // 1024, nil
+//	pintui.ParseSize("1k")       // 1024, nil
 //	pintui.ParseSize("100MB")    // 104857600, nil
 //	pintui.ParseSize("1.5GB")    // 1610612736, nil
 //	pintui.ParseSize("invalid")  // 0, error
@@ -65,6 +75,18 @@ func ParseSize(s string) (uint64, error) {
 	var multiplier uint64 = 1
 
 	switch {
+	case strings.HasSuffix(s, "TIB"):
+		numStr = strings.TrimSuffix(s, "TIB")
+		multiplier = TB
+	case strings.HasSuffix(s, "GIB"):
+		numStr = strings.TrimSuffix(s, "GIB")
+		multiplier = GB
+	case strings.HasSuffix(s, "MIB"):
+		numStr = strings.TrimSuffix(s, "MIB")
+		multiplier = MB
+	case strings.HasSuffix(s, "KIB"):
+		numStr = strings.TrimSuffix(s, "KIB")
+		multiplier = KB
 	case strings.HasSuffix(s, "TB"):
 		numStr = strings.TrimSuffix(s, "TB")
 		multiplier = TB
@@ -77,6 +99,18 @@ func ParseSize(s string) (uint64, error) {
 	case strings.HasSuffix(s, "KB"):
 		numStr = strings.TrimSuffix(s, "KB")
 		multiplier = KB
+	case strings.HasSuffix(s, "T"):
+		numStr = strings.TrimSuffix(s, "T")
+		multiplier = TB
+	case strings.HasSuffix(s, "G"):
+		numStr = strings.TrimSuffix(s, "G")
+		multiplier = GB
+	case strings.HasSuffix(s, "M"):
+		numStr = strings.TrimSuffix(s, "M")
+		multiplier = MB
+	case strings.HasSuffix(s, "K"):
+		numStr = strings.TrimSuffix(s, "K")
+		multiplier = KB
 	case strings.HasSuffix(s, "B"):
 		numStr = strings.TrimSuffix(s, "B")
 	default:
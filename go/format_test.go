@@ -47,6 +47,14 @@ func TestParseSize(t *testing.T) {
 		{"1GB", 1024 * 1024 * 1024, false},
 		{"1TB", 1024 * 1024 * 1024 * 1024, false},
 		{"  100MB  ", 100 * 1024 * 1024, false},
+		{"1KiB", 1024, false},
+		{"1MiB", 1024 * 1024, false},
+		{"1GiB", 1024 * 1024 * 1024, false},
+		{"1TiB", 1024 * 1024 * 1024 * 1024, false},
+		{"1k", 1024, false},
+		{"1m", 1024 * 1024, false},
+		{"1g", 1024 * 1024 * 1024, false},
+		{"1t", 1024 * 1024 * 1024 * 1024, false},
 		{"", 0, true},
 		{"abc", 0, true},
 		{"MB", 0, true},
@@ -1,20 +1,124 @@
 package pintui
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
+	"sync"
 
 	"github.com/fatih/color"
 )
 
-var (
-	headerStyle  = color.New(color.Bold)
-	sectionStyle = color.New(color.FgCyan, color.Bold)
-	keyStyle     = color.New(color.Faint)
-	stepStyle    = color.New(color.FgBlue, color.Bold)
-	dividerStyle = color.New(color.Faint)
+// headerStyle and sectionStyle render with the active Theme's Colors.Header
+// and stepStyle with Colors.Info (bold on top of each); keyStyle and
+// dividerStyle render with Colors.Dim. All five re-resolve the theme on
+// every call, so LoadTheme/LoadThemeFS take effect immediately.
+func headerStyle(a ...any) string  { return themeStyle(currentTheme().Colors.Header, color.Bold)(a...) }
+func sectionStyle(a ...any) string { return themeStyle(currentTheme().Colors.Header, color.Bold)(a...) }
+func keyStyle(a ...any) string     { return themeStyle(currentTheme().Colors.Dim)(a...) }
+func stepStyle(a ...any) string    { return themeStyle(currentTheme().Colors.Info, color.Bold)(a...) }
+func dividerStyle(a ...any) string { return themeStyle(currentTheme().Colors.Dim)(a...) }
+
+// OutputMode selects how a Writer renders the layout primitives (Header,
+// Section, KV, Step, Blank, Divider, Indent).
+type OutputMode int
+
+const (
+	// OutputText renders colored, human-readable console output (the
+	// default), honoring the active color/Unicode capabilities and Theme.
+	OutputText OutputMode = iota
+	// OutputNoColor renders the same layout as OutputText but never emits
+	// ANSI escape codes, regardless of the global color mode.
+	OutputNoColor
+	// OutputJSON renders one indented JSON object per call, e.g.
+	// {"kind":"kv","key":"...","value":"..."}.
+	OutputJSON
+	// OutputNDJSON renders one compact JSON object per call, newline
+	// delimited, suitable for piping into log aggregators.
+	OutputNDJSON
+	// OutputMarkdown renders layout primitives as Markdown.
+	OutputMarkdown
 )
 
+// Writer renders layout primitives to a destination io.Writer in a chosen
+// OutputMode. The package-level Header, Section, KV, Step, Blank, Divider,
+// and Indent functions delegate to a default Writer; use NewWriter to
+// capture or redirect layout output independently (e.g. in tests).
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	w := pintui.NewWriter(&buf, pintui.OutputNDJSON)
+//	w.KV("Version", "1.0.0")
+//	// buf now holds: {"key":"Version","kind":"kv","value":"1.0.0"}
+type Writer struct {
+	mu   sync.Mutex
+	out  io.Writer
+	mode OutputMode
+
+	panicPolicy   PanicPolicy
+	onRenderError func(op string, args []any, r any)
+}
+
+// NewWriter creates a Writer that renders to out in the given mode. By
+// default a panic mid-render is recovered and replaced with a best-effort
+// plain-text fallback (PanicPolicyFallback); pass WithPanicPolicy or
+// WithOnRenderError to customize that behavior.
+func NewWriter(out io.Writer, mode OutputMode, opts ...WriterOption) *Writer {
+	w := &Writer{out: out, mode: mode}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+var defaultWriter = NewWriter(os.Stdout, OutputText)
+
+// SetWriter redirects the destination of the package-level layout
+// functions (Header, Section, KV, Step, Blank, Divider, Indent).
+func SetWriter(out io.Writer) {
+	defaultWriter.mu.Lock()
+	defaultWriter.out = out
+	defaultWriter.mu.Unlock()
+}
+
+// SetOutputMode selects the OutputMode used by the package-level layout
+// functions.
+func SetOutputMode(mode OutputMode) {
+	defaultWriter.mu.Lock()
+	defaultWriter.mode = mode
+	defaultWriter.mu.Unlock()
+}
+
+func (w *Writer) snapshot() (io.Writer, OutputMode) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.out, w.mode
+}
+
+// emitEvent renders a structured record for OutputJSON/OutputNDJSON. kind
+// identifies the layout primitive ("header", "kv", "step", ...) and
+// fields carries its arguments.
+func (w *Writer) emitEvent(out io.Writer, mode OutputMode, kind string, fields map[string]any) {
+	rec := make(map[string]any, len(fields)+1)
+	for k, v := range fields {
+		rec[k] = v
+	}
+	rec["kind"] = kind
+
+	if mode == OutputNDJSON {
+		_ = json.NewEncoder(out).Encode(rec)
+		return
+	}
+	b, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(out, string(b))
+}
+
 // Header prints a header/title with an underline.
 //
 // Headers are bold and followed by a dimmed line of the same width.
@@ -27,10 +131,27 @@ var (
 //	//
 //	// Configuration
 //	// ─────────────
-func Header(title string) {
-	fmt.Println()
-	headerStyle.Println(title)
-	dividerStyle.Println(strings.Repeat("─", len(title)))
+func Header(title string) { defaultWriter.Header(title) }
+
+// Header is the Writer method backing the package-level Header function.
+func (w *Writer) Header(title string) {
+	w.guard("Header", []any{title}, func() {
+		out, mode := w.snapshot()
+		switch mode {
+		case OutputJSON, OutputNDJSON:
+			w.emitEvent(out, mode, "header", map[string]any{"title": title})
+		case OutputMarkdown:
+			fmt.Fprintf(out, "\n## %s\n", title)
+		case OutputNoColor:
+			fmt.Fprintln(out)
+			fmt.Fprintln(out, title)
+			fmt.Fprintln(out, strings.Repeat(dividerRune(), displayWidth(title)))
+		default:
+			fmt.Fprintln(out)
+			fmt.Fprintln(out, headerStyle(title))
+			fmt.Fprintln(out, dividerStyle(strings.Repeat(dividerRune(), displayWidth(title))))
+		}
+	})
 }
 
 // Section prints a section header.
@@ -44,9 +165,25 @@ func Header(title string) {
 //	// Output:
 //	//
 //	// Dependencies
-func Section(title string) {
-	fmt.Println()
-	sectionStyle.Println(title)
+func Section(title string) { defaultWriter.Section(title) }
+
+// Section is the Writer method backing the package-level Section function.
+func (w *Writer) Section(title string) {
+	w.guard("Section", []any{title}, func() {
+		out, mode := w.snapshot()
+		switch mode {
+		case OutputJSON, OutputNDJSON:
+			w.emitEvent(out, mode, "section", map[string]any{"title": title})
+		case OutputMarkdown:
+			fmt.Fprintf(out, "\n### %s\n", title)
+		case OutputNoColor:
+			fmt.Fprintln(out)
+			fmt.Fprintln(out, title)
+		default:
+			fmt.Fprintln(out)
+			fmt.Fprintln(out, sectionStyle(title))
+		}
+	})
 }
 
 // KV prints a key-value pair.
@@ -61,13 +198,32 @@ func Section(title string) {
 //	// Output:
 //	//   Version: 1.0.0
 //	//   Status: Active
-func KV(key, value string) {
-	fmt.Printf("  %s: %s\n", keyStyle.Sprint(key), value)
+func KV(key, value string) { defaultWriter.KV(key, value) }
+
+// KV is the Writer method backing the package-level KV function.
+func (w *Writer) KV(key, value string) {
+	w.guard("KV", []any{key, value}, func() {
+		out, mode := w.snapshot()
+		switch mode {
+		case OutputJSON, OutputNDJSON:
+			w.emitEvent(out, mode, "kv", map[string]any{"key": key, "value": value})
+		case OutputMarkdown:
+			fmt.Fprintf(out, "- **%s**: %s\n", key, value)
+		case OutputNoColor:
+			fmt.Fprintf(out, "  %s: %s\n", key, value)
+		default:
+			fmt.Fprintf(out, "  %s: %s\n", keyStyle(key), value)
+		}
+	})
 }
 
-// KVf prints a key-value pair with a formatted value.
+// KVf prints a key-value pair with a formatted value. A panic while
+// formatting (e.g. a mismatched verb) is handled by the same PanicPolicy
+// as KV, rather than crashing the caller.
 func KVf(key, format string, a ...any) {
-	KV(key, fmt.Sprintf(format, a...))
+	defaultWriter.guard("KVf", append([]any{key, format}, a...), func() {
+		defaultWriter.KV(key, fmt.Sprintf(format, a...))
+	})
 }
 
 // Step prints a step indicator for multi-step operations.
@@ -84,20 +240,49 @@ func KVf(key, format string, a ...any) {
 //	// [1/3] Fetching dependencies
 //	// [2/3] Compiling
 //	// [3/3] Linking
-func Step(num, total int, msg string) {
-	fmt.Printf("%s %s\n", stepStyle.Sprintf("[%d/%d]", num, total), msg)
+func Step(num, total int, msg string) { defaultWriter.Step(num, total, msg) }
+
+// Step is the Writer method backing the package-level Step function.
+func (w *Writer) Step(num, total int, msg string) {
+	w.guard("Step", []any{num, total, msg}, func() {
+		out, mode := w.snapshot()
+		switch mode {
+		case OutputJSON, OutputNDJSON:
+			w.emitEvent(out, mode, "step", map[string]any{"i": num, "n": total, "text": msg})
+		case OutputMarkdown:
+			fmt.Fprintf(out, "%d. %s\n", num, msg)
+		case OutputNoColor:
+			fmt.Fprintf(out, "[%d/%d] %s\n", num, total, msg)
+		default:
+			fmt.Fprintf(out, "%s %s\n", stepStyle(fmt.Sprintf("[%d/%d]", num, total)), msg)
+		}
+	})
 }
 
-// Stepf prints a step indicator with a formatted message.
+// Stepf prints a step indicator with a formatted message. A panic while
+// formatting is handled by the same PanicPolicy as Step.
 func Stepf(num, total int, format string, a ...any) {
-	Step(num, total, fmt.Sprintf(format, a...))
+	defaultWriter.guard("Stepf", append([]any{num, total, format}, a...), func() {
+		defaultWriter.Step(num, total, fmt.Sprintf(format, a...))
+	})
 }
 
 // Blank prints a blank line for visual separation.
 //
 // Convenience function for adding vertical whitespace.
-func Blank() {
-	fmt.Println()
+func Blank() { defaultWriter.Blank() }
+
+// Blank is the Writer method backing the package-level Blank function.
+func (w *Writer) Blank() {
+	w.guard("Blank", nil, func() {
+		out, mode := w.snapshot()
+		switch mode {
+		case OutputJSON, OutputNDJSON:
+			w.emitEvent(out, mode, "blank", nil)
+		default:
+			fmt.Fprintln(out)
+		}
+	})
 }
 
 // Divider prints a horizontal rule/divider.
@@ -107,13 +292,28 @@ func Blank() {
 //	pintui.Divider(40)
 //	// Output:
 //	// ────────────────────────────────────────
-func Divider(width int) {
-	dividerStyle.Println(strings.Repeat("─", width))
+func Divider(width int) { defaultWriter.Divider(width) }
+
+// Divider is the Writer method backing the package-level Divider function.
+func (w *Writer) Divider(width int) {
+	w.guard("Divider", []any{width}, func() {
+		out, mode := w.snapshot()
+		switch mode {
+		case OutputJSON, OutputNDJSON:
+			w.emitEvent(out, mode, "divider", map[string]any{"width": width})
+		case OutputMarkdown:
+			fmt.Fprintln(out, "---")
+		case OutputNoColor:
+			fmt.Fprintln(out, strings.Repeat(dividerRune(), width))
+		default:
+			fmt.Fprintln(out, dividerStyle(strings.Repeat(dividerRune(), width)))
+		}
+	})
 }
 
 // Indent prints an indented line.
 //
-// Each level adds 2 spaces of indentation.
+// Each level adds the active Theme's indent width (2 spaces by default).
 //
 // Example:
 //
@@ -122,11 +322,27 @@ func Divider(width int) {
 //	// Output:
 //	//   First level
 //	//     Second level
-func Indent(level int, msg string) {
-	fmt.Printf("%s%s\n", strings.Repeat("  ", level), msg)
+func Indent(level int, msg string) { defaultWriter.Indent(level, msg) }
+
+// Indent is the Writer method backing the package-level Indent function.
+func (w *Writer) Indent(level int, msg string) {
+	w.guard("Indent", []any{level, msg}, func() {
+		out, mode := w.snapshot()
+		switch mode {
+		case OutputJSON, OutputNDJSON:
+			w.emitEvent(out, mode, "indent", map[string]any{"level": level, "text": msg})
+		case OutputMarkdown:
+			fmt.Fprintf(out, "%s- %s\n", strings.Repeat("  ", level), msg)
+		default:
+			fmt.Fprintf(out, "%s%s\n", strings.Repeat(" ", currentTheme().IndentWidth*level), msg)
+		}
+	})
 }
 
-// Indentf prints an indented line with a formatted message.
+// Indentf prints an indented line with a formatted message. A panic while
+// formatting is handled by the same PanicPolicy as Indent.
 func Indentf(level int, format string, a ...any) {
-	Indent(level, fmt.Sprintf(format, a...))
+	defaultWriter.guard("Indentf", append([]any{level, format}, a...), func() {
+		defaultWriter.Indent(level, fmt.Sprintf(format, a...))
+	})
 }
@@ -1,6 +1,19 @@
 package pintui
 
-import "testing"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+// crashyStringer's String method dereferences a nil pointer. fmt recovers
+// that runtime panic and formats it inline as "%!s(PANIC=String method: ...)".
+type crashyStringer struct{ p *int }
+
+func (c crashyStringer) String() string { return fmt.Sprint(*c.p) }
 
 // TestLayoutDoNotPanic verifies that layout functions don't panic
 func TestLayoutDoNotPanic(t *testing.T) {
@@ -52,3 +65,173 @@ func TestLayoutDoNotPanic(t *testing.T) {
 		KV("キー", "値")
 	})
 }
+
+func TestWriterOutputModes(t *testing.T) {
+	t.Run("OutputNoColor", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, OutputNoColor)
+		w.KV("Version", "1.0.0")
+		if got := buf.String(); got != "  Version: 1.0.0\n" {
+			t.Errorf("KV() = %q, want %q", got, "  Version: 1.0.0\n")
+		}
+	})
+
+	t.Run("OutputNDJSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, OutputNDJSON)
+		w.KV("Version", "1.0.0")
+
+		var rec map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+			t.Fatalf("output is not valid JSON: %v (%q)", err, buf.String())
+		}
+		if rec["kind"] != "kv" || rec["key"] != "Version" || rec["value"] != "1.0.0" {
+			t.Errorf("rec = %+v, want kind=kv key=Version value=1.0.0", rec)
+		}
+	})
+
+	t.Run("OutputJSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, OutputJSON)
+		w.Step(1, 5, "Fetching")
+
+		var rec map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+			t.Fatalf("output is not valid JSON: %v (%q)", err, buf.String())
+		}
+		if rec["kind"] != "step" || rec["i"] != float64(1) || rec["n"] != float64(5) {
+			t.Errorf("rec = %+v, want kind=step i=1 n=5", rec)
+		}
+	})
+
+	t.Run("OutputMarkdown", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, OutputMarkdown)
+		w.Header("Title")
+		w.KV("key", "value")
+		w.Divider(10)
+
+		got := buf.String()
+		if !strings.Contains(got, "## Title") || !strings.Contains(got, "- **key**: value") || !strings.Contains(got, "---") {
+			t.Errorf("markdown output = %q, missing expected fragments", got)
+		}
+	})
+
+	t.Run("HeaderUnderlineMatchesCJKDisplayWidth", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, OutputNoColor)
+		w.Header("日本語ヘッダー")
+
+		lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+		if len(lines) != 3 {
+			t.Fatalf("Header() produced %d lines, want 3: %q", len(lines), buf.String())
+		}
+		title, underline := lines[1], lines[2]
+		if displayWidth(underline) != displayWidth(title) {
+			t.Errorf("underline width = %d, want %d (title %q, underline %q)", displayWidth(underline), displayWidth(title), title, underline)
+		}
+	})
+
+	t.Run("PanicPolicyFallbackRecoversAndRendersRawArgs", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, OutputNoColor)
+
+		var gotOp string
+		var gotArgs []any
+		w.onRenderError = func(op string, args []any, r any) {
+			gotOp, gotArgs = op, args
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("panic escaped despite PanicPolicyFallback: %v", r)
+				}
+			}()
+			w.guard("Header", []any{"boom"}, func() { panic("render exploded") })
+		}()
+
+		if gotOp != "Header" || len(gotArgs) != 1 || gotArgs[0] != "boom" {
+			t.Errorf("onRenderError called with op=%q args=%v, want op=Header args=[boom]", gotOp, gotArgs)
+		}
+		if !strings.Contains(buf.String(), "boom") {
+			t.Errorf("fallback render = %q, want it to mention the raw arg %q", buf.String(), "boom")
+		}
+	})
+
+	t.Run("PanicPolicyRecoverSuppressesOutput", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, OutputNoColor, WithPanicPolicy(PanicPolicyRecover))
+
+		w.guard("KV", []any{"k", "v"}, func() { panic("boom") })
+
+		if buf.String() != "" {
+			t.Errorf("buf = %q, want no output under PanicPolicyRecover", buf.String())
+		}
+	})
+
+	t.Run("PanicPolicyPropagateReraisesPanic", func(t *testing.T) {
+		w := NewWriter(&bytes.Buffer{}, OutputNoColor, WithPanicPolicy(PanicPolicyPropagate))
+
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic to propagate, but it didn't")
+			}
+		}()
+		w.guard("KV", nil, func() { panic("boom") })
+	})
+
+	t.Run("KVfPanicInFormattingIsRecovered", func(t *testing.T) {
+		// A Stringer whose String() method dereferences a nil pointer
+		// panics inside Sprintf; fmt recovers it and renders a
+		// %!s(PANIC=...) marker, so KVf must return without panicking.
+		orig := defaultWriter
+		var buf bytes.Buffer
+		defaultWriter = NewWriter(&buf, OutputNoColor)
+		defer func() { defaultWriter = orig }()
+
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("panic escaped KVf: %v", r)
+				}
+			}()
+			KVf("key", "%s", crashyStringer{})
+		}()
+	})
+
+	t.Run("MonochromeThemeProducesEscapeFreeOutput", func(t *testing.T) {
+		defer resetTheme()
+		setCurrentTheme(MonochromeTheme())
+
+		SetColorMode(ColorAlways)
+		defer SetColorMode(ColorAuto)
+
+		var buf bytes.Buffer
+		w := NewWriter(&buf, OutputText)
+		w.Header("Title")
+		w.Section("Section")
+		w.KV("key", "value")
+		w.Step(1, 2, "step")
+		w.Divider(5)
+
+		if strings.Contains(buf.String(), "\x1b[") {
+			t.Errorf("output under MonochromeTheme contains raw ANSI escapes: %q", buf.String())
+		}
+	})
+
+	t.Run("SetWriterRedirectsPackageFuncs", func(t *testing.T) {
+		var buf bytes.Buffer
+		SetWriter(&buf)
+		SetOutputMode(OutputNoColor)
+		defer func() {
+			SetWriter(os.Stdout)
+			SetOutputMode(OutputText)
+		}()
+
+		KV("captured", "yes")
+		if !strings.Contains(buf.String(), "captured: yes") {
+			t.Errorf("buf = %q, want it to contain %q", buf.String(), "captured: yes")
+		}
+	})
+}
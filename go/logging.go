@@ -0,0 +1,193 @@
+package pintui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Level controls which messages Info/Success/Warn/Error/Dim actually emit.
+type Level int
+
+const (
+	// LevelQuiet suppresses everything except Error.
+	LevelQuiet Level = iota
+	// LevelNormal is the default: Info/Success/Warn/Error/Dim all print.
+	LevelNormal
+	// LevelVerbose is reserved for callers that want extra detail from
+	// future diagnostic helpers; today it behaves like LevelNormal.
+	LevelVerbose
+	// LevelDebug is reserved for future debug-only output.
+	LevelDebug
+)
+
+// Format selects how messages are encoded before they reach their sink.
+type Format int
+
+const (
+	// FormatPretty is the default colored, icon-prefixed console output.
+	FormatPretty Format = iota
+	// FormatJSON emits one JSON object per message, e.g.
+	// {"level":"info","msg":"...","ts":"..."}.
+	FormatJSON
+	// FormatLogfmt emits one logfmt line per message, e.g.
+	// level=info msg="..." ts=....
+	FormatLogfmt
+)
+
+// sink is the package-wide message destination used by Info/Success/Warn/
+// Error/Dim and the spinner/bar finishers. It is safe for concurrent use.
+var sink = newLogSink()
+
+type logSink struct {
+	mu     sync.Mutex
+	level  Level
+	format Format
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func newLogSink() *logSink {
+	return &logSink{
+		level:  LevelNormal,
+		format: FormatPretty,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+	}
+}
+
+func (s *logSink) snapshot() (level Level, format Format, stdout, stderr io.Writer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.level, s.format, s.stdout, s.stderr
+}
+
+func (s *logSink) currentFormat() Format {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.format
+}
+
+// SetLevel sets the minimum level at which Info/Success/Warn/Error/Dim emit.
+// LevelQuiet suppresses everything but Error.
+func SetLevel(level Level) {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	sink.level = level
+}
+
+// SetOutput overrides the stdout and stderr destinations used by the
+// package-level message functions, spinners, and bars. Pass nil for either
+// writer to leave it unchanged.
+func SetOutput(stdout, stderr io.Writer) {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	if stdout != nil {
+		sink.stdout = stdout
+	}
+	if stderr != nil {
+		sink.stderr = stderr
+	}
+}
+
+// SetFormat selects the encoding used for messages: FormatPretty (the
+// default colored console output), FormatJSON, or FormatLogfmt.
+func SetFormat(format Format) {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	sink.format = format
+}
+
+// minLevelFor reports the Level a message kind requires before it is
+// emitted. Only "error" survives LevelQuiet.
+func minLevelFor(kind string) Level {
+	if kind == "error" {
+		return LevelQuiet
+	}
+	return LevelNormal
+}
+
+// emit writes a message through the package sink, applying level filtering
+// and encoding it as pretty console output, JSON, or logfmt depending on
+// the configured Format. pretty is the fully rendered pretty-mode line
+// (icon and all) with no trailing newline.
+func emit(kind, pretty, msg string, fields map[string]any) {
+	level, format, stdout, stderr := sink.snapshot()
+	if level < minLevelFor(kind) {
+		return
+	}
+
+	out := stdout
+	if kind == "error" {
+		out = stderr
+	}
+
+	switch format {
+	case FormatJSON:
+		rec := make(map[string]any, len(fields)+3)
+		for k, v := range fields {
+			rec[k] = v
+		}
+		rec["level"] = kind
+		rec["msg"] = msg
+		rec["ts"] = time.Now().Format(time.RFC3339)
+		enc := json.NewEncoder(out)
+		_ = enc.Encode(rec)
+	case FormatLogfmt:
+		fmt.Fprintf(out, "level=%s msg=%q ts=%s", kind, msg, time.Now().Format(time.RFC3339))
+		for k, v := range fields {
+			fmt.Fprintf(out, " %s=%v", k, v)
+		}
+		fmt.Fprintln(out)
+	default:
+		fmt.Fprintln(out, pretty)
+	}
+}
+
+// FieldLogger is a scoped logger returned by WithFields. Its calls behave
+// like the package-level Info/Success/Warn/Error/Dim functions but carry a
+// fixed set of structured fields in FormatJSON/FormatLogfmt mode.
+type FieldLogger struct {
+	fields map[string]any
+}
+
+// WithFields returns a FieldLogger whose subsequent calls carry the given
+// fields. Useful for CLIs that need machine-readable output under --json
+// while keeping the pretty TTY experience by default.
+//
+// Example:
+//
+//	log := pintui.WithFields(map[string]any{"job": "sync"})
+//	log.Info("starting")
+//	// FormatJSON: {"job":"sync","level":"info","msg":"starting","ts":"..."}
+func WithFields(fields map[string]any) *FieldLogger {
+	return &FieldLogger{fields: fields}
+}
+
+// Info prints an info message carrying the logger's fields.
+func (l *FieldLogger) Info(msg string) {
+	emit("info", fmt.Sprintf("%s %s", icon("info"), msg), msg, l.fields)
+}
+
+// Success prints a success message carrying the logger's fields.
+func (l *FieldLogger) Success(msg string) {
+	emit("success", fmt.Sprintf("%s %s", icon("success"), msg), msg, l.fields)
+}
+
+// Warn prints a warning message carrying the logger's fields.
+func (l *FieldLogger) Warn(msg string) {
+	emit("warn", fmt.Sprintf("%s %s", icon("warn"), msg), msg, l.fields)
+}
+
+// Error prints an error message carrying the logger's fields.
+func (l *FieldLogger) Error(msg string) {
+	emit("error", fmt.Sprintf("%s %s", icon("error"), msg), msg, l.fields)
+}
+
+// Dim prints a dim/muted message carrying the logger's fields.
+func (l *FieldLogger) Dim(msg string) {
+	emit("dim", fmt.Sprintf("  %s", dimStyle(msg)), msg, l.fields)
+}
@@ -0,0 +1,90 @@
+package pintui
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func resetSink() {
+	sink.mu.Lock()
+	sink.level = LevelNormal
+	sink.format = FormatPretty
+	sink.stdout = os.Stdout
+	sink.stderr = os.Stderr
+	sink.mu.Unlock()
+}
+
+func TestSetLevelQuietSuppressesExceptError(t *testing.T) {
+	defer resetSink()
+
+	var out, errOut bytes.Buffer
+	SetOutput(&out, &errOut)
+	SetLevel(LevelQuiet)
+
+	Info("hidden")
+	Success("hidden")
+	Error("shown")
+
+	if out.Len() != 0 {
+		t.Errorf("stdout = %q, want empty under LevelQuiet", out.String())
+	}
+	if !strings.Contains(errOut.String(), "shown") {
+		t.Errorf("stderr = %q, want it to contain %q", errOut.String(), "shown")
+	}
+}
+
+func TestSetFormatJSON(t *testing.T) {
+	defer resetSink()
+
+	var out bytes.Buffer
+	SetOutput(&out, &out)
+	SetLevel(LevelNormal)
+	SetFormat(FormatJSON)
+
+	Info("hello")
+
+	var rec map[string]any
+	if err := json.Unmarshal(out.Bytes(), &rec); err != nil {
+		t.Fatalf("output is not valid JSON: %v (%q)", err, out.String())
+	}
+	if rec["msg"] != "hello" || rec["level"] != "info" {
+		t.Errorf("rec = %+v, want msg=hello level=info", rec)
+	}
+}
+
+func TestWithFields(t *testing.T) {
+	defer resetSink()
+
+	var out bytes.Buffer
+	SetOutput(&out, &out)
+	SetFormat(FormatJSON)
+
+	log := WithFields(map[string]any{"job": "sync"})
+	log.Success("done")
+
+	var rec map[string]any
+	if err := json.Unmarshal(out.Bytes(), &rec); err != nil {
+		t.Fatalf("output is not valid JSON: %v (%q)", err, out.String())
+	}
+	if rec["job"] != "sync" {
+		t.Errorf("rec[job] = %v, want sync", rec["job"])
+	}
+}
+
+func TestSetFormatLogfmt(t *testing.T) {
+	defer resetSink()
+
+	var out bytes.Buffer
+	SetOutput(&out, &out)
+	SetFormat(FormatLogfmt)
+
+	Warn("careful")
+
+	got := out.String()
+	if !strings.Contains(got, "level=warn") || !strings.Contains(got, `msg="careful"`) {
+		t.Errorf("output = %q, want level=warn and msg=\"careful\"", got)
+	}
+}
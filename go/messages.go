@@ -4,20 +4,11 @@
 // with consistent styling across your CLI application.
 package pintui
 
-import (
-	"fmt"
-	"os"
+import "fmt"
 
-	"github.com/fatih/color"
-)
-
-var (
-	infoIcon    = color.New(color.FgBlue).Sprint("ℹ")
-	successIcon = color.New(color.FgGreen).Sprint("✓")
-	warnIcon    = color.New(color.FgYellow).Sprint("⚠")
-	errorIcon   = color.New(color.FgRed).Sprint("✗")
-	dimStyle    = color.New(color.Faint)
-)
+// dimStyle renders with the active Theme's Colors.Dim, re-resolving the
+// theme on every call so LoadTheme/LoadThemeFS take effect immediately.
+func dimStyle(a ...any) string { return themeStyle(currentTheme().Colors.Dim)(a...) }
 
 // Info prints an info message with a blue ℹ icon.
 //
@@ -28,7 +19,7 @@ var (
 //	pintui.Info("Processing 42 files...")
 //	// Output: ℹ Processing 42 files...
 func Info(msg string) {
-	fmt.Printf("%s %s\n", infoIcon, msg)
+	emit("info", fmt.Sprintf("%s %s", icon("info"), msg), msg, nil)
 }
 
 // Infof prints a formatted info message with a blue ℹ icon.
@@ -45,7 +36,7 @@ func Infof(format string, a ...any) {
 //	pintui.Success("All tests passed")
 //	// Output: ✓ All tests passed
 func Success(msg string) {
-	fmt.Printf("%s %s\n", successIcon, msg)
+	emit("success", fmt.Sprintf("%s %s", icon("success"), msg), msg, nil)
 }
 
 // Successf prints a formatted success message with a green ✓ icon.
@@ -62,7 +53,7 @@ func Successf(format string, a ...any) {
 //	pintui.Warn("Config file not found, using defaults")
 //	// Output: ⚠ Config file not found, using defaults
 func Warn(msg string) {
-	fmt.Printf("%s %s\n", warnIcon, msg)
+	emit("warn", fmt.Sprintf("%s %s", icon("warn"), msg), msg, nil)
 }
 
 // Warnf prints a formatted warning message with a yellow ⚠ icon.
@@ -79,7 +70,7 @@ func Warnf(format string, a ...any) {
 //	pintui.Error("Failed to connect to database")
 //	// Output (stderr): ✗ Failed to connect to database
 func Error(msg string) {
-	fmt.Fprintf(os.Stderr, "%s %s\n", errorIcon, msg)
+	emit("error", fmt.Sprintf("%s %s", icon("error"), msg), msg, nil)
 }
 
 // Errorf prints a formatted error message with a red ✗ icon to stderr.
@@ -98,7 +89,7 @@ func Errorf(format string, a ...any) {
 //	pintui.Dim("Output: ./bin/myapp")
 //	pintui.Dim("Size: 4.2 MB")
 func Dim(msg string) {
-	fmt.Printf("  %s\n", dimStyle.Sprint(msg))
+	emit("dim", fmt.Sprintf("  %s", dimStyle(msg)), msg, nil)
 }
 
 // Dimf prints a formatted dim/muted message.
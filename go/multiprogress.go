@@ -0,0 +1,380 @@
+package pintui
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+// ewmaAlpha is the smoothing factor used for the per-bar throughput EWMA
+// that drives ETA estimates. Lower values smooth more aggressively.
+const ewmaAlpha = 0.25
+
+// spinnerTick is the interval at which NewMultiProgress's animation loop
+// advances spinner frames and repaints the interactive block.
+const spinnerTick = 100 * time.Millisecond
+
+// MultiProgress coordinates several concurrent bars and/or spinners,
+// redrawing them together as a single, non-interleaved block.
+//
+// Example:
+//
+//	mp := pintui.NewMultiProgress()
+//	b1 := mp.AddBar(100, "download A")
+//	b2 := mp.AddBar(100, "download B")
+//	go func() {
+//	    for i := 0; i < 100; i++ {
+//	        b1.Add(1)
+//	    }
+//	    b1.Success("download A done")
+//	}()
+//	go func() {
+//	    for i := 0; i < 100; i++ {
+//	        b2.Add(1)
+//	    }
+//	    b2.Success("download B done")
+//	}()
+//	mp.Wait()
+//
+// On a non-TTY stdout, MultiProgress falls back to printing one line per
+// update instead of repainting in place, so redirected output and CI logs
+// stay readable.
+type MultiProgress struct {
+	mu           sync.Mutex
+	items        []*multiItem
+	keepFinished bool
+	lastLines    int
+	interactive  bool
+	wg           sync.WaitGroup
+	stopAnimate  chan struct{}
+	stopOnce     sync.Once
+}
+
+// MultiProgressOption configures a MultiProgress created by NewMultiProgress.
+type MultiProgressOption func(*MultiProgress)
+
+// WithKeepFinished controls whether completed bars/spinners stay printed
+// above the active block (true, the default) or are removed from the
+// redraw entirely once finished.
+func WithKeepFinished(keep bool) MultiProgressOption {
+	return func(mp *MultiProgress) {
+		mp.keepFinished = keep
+	}
+}
+
+// NewMultiProgress creates a coordinator for concurrent bars and spinners.
+func NewMultiProgress(opts ...MultiProgressOption) *MultiProgress {
+	mp := &MultiProgress{
+		keepFinished: true,
+		interactive:  term.IsTerminal(int(os.Stdout.Fd())),
+		stopAnimate:  make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(mp)
+	}
+	go mp.animate()
+	return mp
+}
+
+// animate periodically advances spinner frames and repaints the block,
+// so a lone spinner keeps animating even when nothing calls Add/Set. It
+// runs until Wait returns.
+func (mp *MultiProgress) animate() {
+	ticker := time.NewTicker(spinnerTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-mp.stopAnimate:
+			return
+		case <-ticker.C:
+			mp.tick()
+		}
+	}
+}
+
+func (mp *MultiProgress) tick() {
+	mp.mu.Lock()
+	animating := false
+	for _, it := range mp.items {
+		if it.kind == "spinner" && !it.done && !it.removed {
+			it.frame++
+			animating = true
+		}
+	}
+	mp.mu.Unlock()
+	if animating {
+		mp.redraw(nil)
+	}
+}
+
+// multiItem is the shared state behind a MultiBarHandle or MultiSpinnerHandle.
+type multiItem struct {
+	kind    string // "bar" or "spinner"
+	label   string
+	total   int64
+	current int64
+
+	done       bool
+	removed    bool
+	finishMsg  string
+	finishIcon string
+
+	lastUpdate time.Time
+	avgRate    float64
+	frame      int
+}
+
+// MultiBarHandle is a determinate bar managed by a MultiProgress.
+type MultiBarHandle struct {
+	mp   *MultiProgress
+	item *multiItem
+}
+
+// MultiSpinnerHandle is an indeterminate spinner managed by a MultiProgress.
+type MultiSpinnerHandle struct {
+	mp   *MultiProgress
+	item *multiItem
+}
+
+// AddBar registers a new determinate bar with the given total and label.
+func (mp *MultiProgress) AddBar(total int64, label string) *MultiBarHandle {
+	mp.mu.Lock()
+	item := &multiItem{kind: "bar", label: label, total: total, lastUpdate: time.Now()}
+	mp.items = append(mp.items, item)
+	mp.wg.Add(1)
+	mp.mu.Unlock()
+	mp.redraw(item)
+	return &MultiBarHandle{mp: mp, item: item}
+}
+
+// AddSpinner registers a new indeterminate spinner with the given label.
+func (mp *MultiProgress) AddSpinner(label string) *MultiSpinnerHandle {
+	mp.mu.Lock()
+	item := &multiItem{kind: "spinner", label: label, lastUpdate: time.Now()}
+	mp.items = append(mp.items, item)
+	mp.wg.Add(1)
+	mp.mu.Unlock()
+	mp.redraw(item)
+	return &MultiSpinnerHandle{mp: mp, item: item}
+}
+
+// Remove drops a bar or spinner from the redraw block immediately,
+// regardless of the WithKeepFinished setting. It is safe to call before the
+// item has finished: Remove counts it down for Wait just like Success/Error/
+// Warn would, so an unfinished handle that's removed doesn't block Wait
+// forever.
+func (mp *MultiProgress) Remove(h any) {
+	var item *multiItem
+	switch v := h.(type) {
+	case *MultiBarHandle:
+		item = v.item
+	case *MultiSpinnerHandle:
+		item = v.item
+	default:
+		return
+	}
+
+	mp.mu.Lock()
+	item.removed = true
+	alreadyDone := item.done
+	item.done = true
+	mp.mu.Unlock()
+	mp.redraw(item)
+	if !alreadyDone {
+		mp.wg.Done()
+	}
+}
+
+// Wait blocks until every bar and spinner added to mp has finished
+// (via Success, Error, or Warn).
+func (mp *MultiProgress) Wait() {
+	mp.wg.Wait()
+	mp.stopOnce.Do(func() { close(mp.stopAnimate) })
+}
+
+// Add increments a bar's progress and recomputes its EWMA throughput.
+func (b *MultiBarHandle) Add(n int64) {
+	b.mp.update(b.item, func(it *multiItem) {
+		it.current += n
+	})
+}
+
+// Set sets a bar's progress to an absolute value.
+func (b *MultiBarHandle) Set(n int64) {
+	b.mp.update(b.item, func(it *multiItem) {
+		it.current = n
+	})
+}
+
+// Success marks the bar as finished and shows a success message.
+func (b *MultiBarHandle) Success(msg string) {
+	b.mp.finish(b.item, msg, icon("success"))
+}
+
+// Error marks the bar as finished and shows an error message.
+func (b *MultiBarHandle) Error(msg string) {
+	b.mp.finish(b.item, msg, icon("error"))
+}
+
+// UpdateMessage updates a spinner's label while it keeps animating.
+func (s *MultiSpinnerHandle) UpdateMessage(msg string) {
+	s.mp.mu.Lock()
+	s.item.label = msg
+	s.mp.mu.Unlock()
+	s.mp.redraw(s.item)
+}
+
+// Success marks the spinner as finished and shows a success message.
+func (s *MultiSpinnerHandle) Success(msg string) {
+	s.mp.finish(s.item, msg, icon("success"))
+}
+
+// Error marks the spinner as finished and shows an error message.
+func (s *MultiSpinnerHandle) Error(msg string) {
+	s.mp.finish(s.item, msg, icon("error"))
+}
+
+// Warn marks the spinner as finished and shows a warning message.
+func (s *MultiSpinnerHandle) Warn(msg string) {
+	s.mp.finish(s.item, msg, icon("warn"))
+}
+
+func (mp *MultiProgress) update(item *multiItem, mutate func(*multiItem)) {
+	mp.mu.Lock()
+	now := time.Now()
+	prevCurrent := item.current
+	mutate(item)
+	delta := item.current - prevCurrent
+	elapsed := now.Sub(item.lastUpdate).Seconds()
+	if delta > 0 && elapsed > 0 {
+		rate := float64(delta) / elapsed
+		item.avgRate = ewmaAlpha*rate + (1-ewmaAlpha)*item.avgRate
+	}
+	item.lastUpdate = now
+	item.frame++
+	mp.mu.Unlock()
+	mp.redraw(item)
+}
+
+func (mp *MultiProgress) finish(item *multiItem, msg, icon string) {
+	mp.mu.Lock()
+	if item.done {
+		mp.mu.Unlock()
+		return
+	}
+	item.done = true
+	item.finishMsg = msg
+	item.finishIcon = icon
+	mp.mu.Unlock()
+	mp.redraw(item)
+	mp.wg.Done()
+}
+
+// eta estimates the remaining time for a bar from its EWMA throughput.
+// It returns "--" while the rate isn't yet known, and "0ms" once nothing
+// is left.
+func (it *multiItem) eta() string {
+	if it.kind != "bar" || it.avgRate <= 0 {
+		return "--"
+	}
+	remaining := it.total - it.current
+	if remaining <= 0 {
+		return "0ms"
+	}
+	return HumanDuration(time.Duration(float64(remaining)/it.avgRate) * time.Second)
+}
+
+func (it *multiItem) render() string {
+	switch it.kind {
+	case "bar":
+		pct := 0.0
+		if it.total > 0 {
+			pct = float64(it.current) / float64(it.total) * 100
+		}
+		return fmt.Sprintf("%s %s %d/%d (%.0f%%) eta %s",
+			it.statusIcon(), it.label, it.current, it.total, pct, it.eta())
+	default: // spinner
+		frames := []rune(spinnerGlyphs())
+		glyph := string(frames[it.frame%len(frames)])
+		if it.done {
+			glyph = it.finishIcon
+		}
+		return fmt.Sprintf("%s %s", glyph, it.label)
+	}
+}
+
+func (it *multiItem) statusIcon() string {
+	if it.done {
+		return it.finishIcon
+	}
+	return color.CyanString(promptCursorGlyph())
+}
+
+// spinnerFrames mirrors the braille charset used by Spinner/StageProgress.
+const spinnerFrames = "⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏"
+
+// asciiSpinnerFrames is the spinnerFrames fallback for unicodeEnabled() ==
+// false.
+const asciiSpinnerFrames = `-\|/`
+
+// spinnerGlyphs returns the animated spinner charset, falling back to
+// asciiSpinnerFrames when unicodeEnabled() is false.
+func spinnerGlyphs() string {
+	if unicodeEnabled() {
+		return spinnerFrames
+	}
+	return asciiSpinnerFrames
+}
+
+// redraw repaints the whole block of active (and, if kept, finished) items
+// in place. changed is the item whose state just changed, or nil for a
+// periodic animation tick. On a non-TTY stdout it instead prints one line
+// for changed so redirected output stays readable. Output goes through the
+// package sink's configured stdout writer, the same as Bar/StageProgress.Next,
+// so SetOutput redirects it too.
+func (mp *MultiProgress) redraw(changed *multiItem) {
+	_, _, stdout, _ := sink.snapshot()
+
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	if !mp.interactive {
+		if changed != nil && !changed.removed {
+			fmt.Fprintln(stdout, changed.render())
+		}
+		return
+	}
+
+	var finished, active []string
+	for _, it := range mp.items {
+		if it.removed {
+			continue
+		}
+		if it.done {
+			if mp.keepFinished {
+				finished = append(finished, it.render())
+			}
+			continue
+		}
+		active = append(active, it.render())
+	}
+	lines := append(finished, active...)
+
+	if mp.lastLines > 0 {
+		fmt.Fprintf(stdout, "\033[%dA", mp.lastLines)
+	}
+	for _, line := range lines {
+		fmt.Fprint(stdout, "\033[2K"+line+"\n")
+	}
+	if len(lines) < mp.lastLines {
+		// The block shrank (a keepFinished=false finish, or Remove): clear
+		// everything below the new last line so stale rows from the
+		// previous, taller repaint don't stick around forever.
+		fmt.Fprint(stdout, "\033[J")
+	}
+	mp.lastLines = len(lines)
+}
@@ -0,0 +1,125 @@
+package pintui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMultiProgress(t *testing.T) {
+	t.Run("BarsAndSpinner", func(t *testing.T) {
+		mp := NewMultiProgress()
+		b1 := mp.AddBar(10, "download A")
+		b2 := mp.AddBar(10, "download B")
+		s := mp.AddSpinner("verifying")
+
+		b1.Add(5)
+		b1.Add(5)
+		b2.Set(10)
+		b1.Success("download A done")
+		b2.Success("download B done")
+		s.Success("verified")
+
+		mp.Wait()
+	})
+
+	t.Run("KeepFinishedFalse", func(t *testing.T) {
+		mp := NewMultiProgress(WithKeepFinished(false))
+		b := mp.AddBar(1, "quick")
+		b.Success("done")
+		mp.Wait()
+	})
+
+	t.Run("RemoveHandle", func(t *testing.T) {
+		mp := NewMultiProgress()
+		s := mp.AddSpinner("background")
+		mp.Remove(s)
+		s.Success("done")
+		mp.Wait()
+	})
+
+	t.Run("RemoveWithoutFinishDoesNotBlockWait", func(t *testing.T) {
+		mp := NewMultiProgress()
+		b := mp.AddBar(10, "background")
+		mp.Remove(b) // never calls Success/Error: Wait must still return
+
+		done := make(chan struct{})
+		go func() {
+			mp.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Wait() blocked after Remove with no prior finish")
+		}
+	})
+
+	t.Run("SpinnerGlyphsASCIIFallback", func(t *testing.T) {
+		SetUnicodeMode(UnicodeASCII)
+		defer SetUnicodeMode(UnicodeAuto)
+
+		if got := spinnerGlyphs(); got != asciiSpinnerFrames {
+			t.Errorf("spinnerGlyphs() under UnicodeASCII = %q, want %q", got, asciiSpinnerFrames)
+		}
+
+		mp := NewMultiProgress()
+		s := mp.AddSpinner("working")
+		if out := s.item.render(); strings.ContainsAny(out, spinnerFrames) {
+			t.Errorf("render() under UnicodeASCII = %q, want no Unicode spinner glyphs", out)
+		}
+		s.Success("done")
+		if out := s.item.render(); !strings.Contains(out, "[OK]") {
+			t.Errorf("render() after Success under UnicodeASCII = %q, want ASCII finish icon", out)
+		}
+		mp.Wait()
+	})
+
+	t.Run("DoubleFinishDoesNotPanic", func(t *testing.T) {
+		mp := NewMultiProgress()
+		b := mp.AddBar(1, "flaky")
+		b.Success("done")
+		b.Error("done again") // already done: must be a no-op, not a double wg.Done()
+
+		s := mp.AddSpinner("flaky spinner")
+		s.Success("done")
+		s.Warn("done again")
+
+		mp.Wait()
+	})
+
+	t.Run("RedrawClearsStaleLinesWhenBlockShrinks", func(t *testing.T) {
+		defer resetSink()
+		var out bytes.Buffer
+		SetOutput(&out, &out)
+
+		mp := NewMultiProgress(WithKeepFinished(false))
+		mp.interactive = true // exercise the in-place repaint path without a real TTY
+
+		b1 := mp.AddBar(1, "a")
+		b2 := mp.AddBar(1, "b")
+		b3 := mp.AddBar(1, "c")
+		out.Reset() // only care about the repaint triggered by b1.Success below
+
+		b1.Success("a done") // drops to 2 lines under WithKeepFinished(false)
+
+		if got := out.String(); !strings.Contains(got, "\033[J") {
+			t.Errorf("redraw() after block shrink = %q, want a trailing \\033[J to clear stale lines", got)
+		}
+
+		b2.Success("b done")
+		b3.Success("c done")
+		mp.Wait()
+	})
+
+	t.Run("EtaUnknownUntilProgress", func(t *testing.T) {
+		mp := NewMultiProgress()
+		b := mp.AddBar(100, "slow")
+		if got := b.item.eta(); got != "--" {
+			t.Errorf("eta() before any progress = %q, want \"--\"", got)
+		}
+		b.Success("done")
+		mp.Wait()
+	})
+}
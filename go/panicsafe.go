@@ -0,0 +1,97 @@
+package pintui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PanicPolicy controls what a Writer does when a layout entrypoint panics
+// mid-render (a bad format verb in KVf/Stepf/Indentf, a custom Stringer
+// panicking, etc).
+type PanicPolicy int
+
+const (
+	// PanicPolicyFallback recovers the panic, reports it via OnRenderError
+	// if set, and writes a best-effort plain-text rendering of the raw
+	// arguments in place of the failed output. This is the default: a
+	// formatting bug degrades the output instead of crashing the caller.
+	PanicPolicyFallback PanicPolicy = iota
+	// PanicPolicyRecover recovers the panic and reports it via
+	// OnRenderError, but emits nothing for the failed call.
+	PanicPolicyRecover
+	// PanicPolicyPropagate does not recover; the panic escapes to the
+	// caller. Useful in tests that want a formatting bug to fail loudly.
+	PanicPolicyPropagate
+)
+
+// WriterOption configures optional Writer behavior at construction time.
+type WriterOption func(*Writer)
+
+// WithPanicPolicy sets how the Writer handles a panic during rendering.
+// The default, if omitted, is PanicPolicyFallback.
+func WithPanicPolicy(policy PanicPolicy) WriterOption {
+	return func(w *Writer) { w.panicPolicy = policy }
+}
+
+// WithOnRenderError registers a hook invoked whenever a layout call panics
+// and is recovered. op names the entrypoint ("Header", "KVf", ...), args
+// holds the raw arguments it was called with, and r is the recovered value.
+func WithOnRenderError(fn func(op string, args []any, r any)) WriterOption {
+	return func(w *Writer) { w.onRenderError = fn }
+}
+
+// SetPanicPolicy sets the PanicPolicy used by the package-level layout
+// functions (Header, Section, KV, Step, Blank, Divider, Indent, and their
+// f-suffixed variants).
+func SetPanicPolicy(policy PanicPolicy) {
+	defaultWriter.mu.Lock()
+	defaultWriter.panicPolicy = policy
+	defaultWriter.mu.Unlock()
+}
+
+// SetOnRenderError registers the OnRenderError hook used by the
+// package-level layout functions.
+func SetOnRenderError(fn func(op string, args []any, r any)) {
+	defaultWriter.mu.Lock()
+	defaultWriter.onRenderError = fn
+	defaultWriter.mu.Unlock()
+}
+
+func (w *Writer) panicSnapshot() (PanicPolicy, func(op string, args []any, r any)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.panicPolicy, w.onRenderError
+}
+
+// guard runs fn, recovering a panic according to the Writer's PanicPolicy.
+// op and args identify the call for OnRenderError and the fallback render.
+func (w *Writer) guard(op string, args []any, fn func()) {
+	policy, hook := w.panicSnapshot()
+	if policy == PanicPolicyPropagate {
+		fn()
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if hook != nil {
+				hook(op, args, r)
+			}
+			if policy == PanicPolicyFallback {
+				w.renderFallback(op, args)
+			}
+		}
+	}()
+	fn()
+}
+
+// renderFallback writes a best-effort plain-text rendering of a failed
+// call's raw arguments, so a formatting panic degrades output rather than
+// losing it entirely.
+func (w *Writer) renderFallback(op string, args []any) {
+	out, _ := w.snapshot()
+	parts := make([]string, len(args))
+	for i, a := range args {
+		parts[i] = fmt.Sprint(a)
+	}
+	fmt.Fprintf(out, "[pintui: %s render failed] %s\n", op, strings.Join(parts, " "))
+}
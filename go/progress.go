@@ -2,7 +2,6 @@ package pintui
 
 import (
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -21,10 +20,18 @@ import (
 //	// ... do work ...
 //	s.Success("Configuration loaded")
 func Spinner(msg string) *SpinnerHandle {
-	s := spinner.New(spinner.CharSets[14], 80*time.Millisecond) // CharSet 14 is ⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏
+	_, format, stdout, _ := sink.snapshot()
+	s := spinner.New(spinner.CharSets[currentTheme().SpinnerCharset], 80*time.Millisecond, spinner.WithWriter(stdout))
 	s.Suffix = " " + msg
 	s.Color("cyan")
-	s.Start()
+
+	if format == FormatPretty {
+		s.Start()
+	} else {
+		// JSON/logfmt output can't animate, so emit a single start event
+		// instead and let Success/Error/Warn emit the matching finish event.
+		emit("info", "", msg, map[string]any{"event": "spinner_start"})
+	}
 	return &SpinnerHandle{spinner: s, msg: msg}
 }
 
@@ -44,7 +51,7 @@ type SpinnerHandle struct {
 //	// Output: ✓ Connected
 func (s *SpinnerHandle) Success(msg string) {
 	s.spinner.Stop()
-	fmt.Printf("\r%s %s\n", color.GreenString("✓"), msg)
+	emit("success", fmt.Sprintf("\r%s %s", icon("success"), msg), msg, map[string]any{"event": "spinner_finish"})
 }
 
 // Error stops the spinner and shows an error message.
@@ -57,7 +64,7 @@ func (s *SpinnerHandle) Success(msg string) {
 //	// Output: ✗ Connection failed
 func (s *SpinnerHandle) Error(msg string) {
 	s.spinner.Stop()
-	fmt.Fprintf(os.Stderr, "\r%s %s\n", color.RedString("✗"), msg)
+	emit("error", fmt.Sprintf("\r%s %s", icon("error"), msg), msg, map[string]any{"event": "spinner_finish"})
 }
 
 // Warn stops the spinner and shows a warning message.
@@ -70,7 +77,7 @@ func (s *SpinnerHandle) Error(msg string) {
 //	// Output: ⚠ Completed with warnings
 func (s *SpinnerHandle) Warn(msg string) {
 	s.spinner.Stop()
-	fmt.Printf("\r%s %s\n", color.YellowString("⚠"), msg)
+	emit("warn", fmt.Sprintf("\r%s %s", icon("warn"), msg), msg, map[string]any{"event": "spinner_finish"})
 }
 
 // Clear stops the spinner and clears it from display.
@@ -100,58 +107,89 @@ func (s *SpinnerHandle) UpdateMessage(msg string) {
 //	}
 //	bar.Success("Downloaded")
 func Bar(total int64, prefix string) *BarHandle {
+	t := currentTheme()
+	_, format, stdout, _ := sink.snapshot()
 	bar := progressbar.NewOptions64(total,
 		progressbar.OptionSetDescription(prefix),
 		progressbar.OptionSetWidth(40),
 		progressbar.OptionEnableColorCodes(true),
 		progressbar.OptionSetTheme(progressbar.Theme{
-			Saucer:        "[cyan]━[reset]",
-			SaucerHead:    "[cyan]╸[reset]",
-			SaucerPadding: "[blue]─[reset]",
+			Saucer:        "[cyan]" + t.Bar.Saucer + "[reset]",
+			SaucerHead:    "[cyan]" + t.Bar.SaucerHead + "[reset]",
+			SaucerPadding: "[blue]" + t.Bar.Padding + "[reset]",
 			BarStart:      "[",
 			BarEnd:        "]",
 		}),
 		progressbar.OptionShowCount(),
-		progressbar.OptionSpinnerType(14),
+		progressbar.OptionSpinnerType(t.SpinnerCharset),
+		progressbar.OptionSetWriter(stdout),
+		// JSON/logfmt output can't animate, so hide the ANSI bar and emit
+		// progress events from Add/Set instead.
+		progressbar.OptionSetVisibility(format == FormatPretty),
 	)
-	return &BarHandle{bar: bar}
+	return &BarHandle{bar: bar, prefix: prefix, total: total}
 }
 
 // BarHandle wraps a progress bar and provides helper methods.
 type BarHandle struct {
-	bar *progressbar.ProgressBar
+	bar     *progressbar.ProgressBar
+	prefix  string
+	total   int64
+	current int64
 }
 
 // Add increments the progress bar by the given amount.
 func (b *BarHandle) Add(n int) {
-	b.bar.Add(n)
+	b.Add64(int64(n))
 }
 
 // Add64 increments the progress bar by the given int64 amount.
 func (b *BarHandle) Add64(n int64) {
 	b.bar.Add64(n)
+	b.current += n
+	b.emitProgress()
 }
 
 // Set sets the progress bar to a specific value.
 func (b *BarHandle) Set(n int) {
-	b.bar.Set(n)
+	b.Set64(int64(n))
 }
 
 // Set64 sets the progress bar to a specific int64 value.
 func (b *BarHandle) Set64(n int64) {
 	b.bar.Set64(n)
+	b.current = n
+	b.emitProgress()
+}
+
+// emitProgress reports a progress event in place of ANSI animation when the
+// sink's format isn't FormatPretty.
+func (b *BarHandle) emitProgress() {
+	if sink.currentFormat() == FormatPretty {
+		return
+	}
+	pct := 0.0
+	if b.total > 0 {
+		pct = float64(b.current) / float64(b.total) * 100
+	}
+	emit("info", "", b.prefix, map[string]any{
+		"event":   "bar_progress",
+		"current": b.current,
+		"total":   b.total,
+		"percent": pct,
+	})
 }
 
 // Success finishes the progress bar with a success message.
 func (b *BarHandle) Success(msg string) {
 	b.bar.Finish()
-	fmt.Printf("\r%s %s\n", color.GreenString("✓"), msg)
+	emit("success", fmt.Sprintf("\r%s %s", icon("success"), msg), msg, map[string]any{"event": "bar_finish"})
 }
 
 // Error finishes the progress bar with an error message.
 func (b *BarHandle) Error(msg string) {
 	b.bar.Finish()
-	fmt.Fprintf(os.Stderr, "\r%s %s\n", color.RedString("✗"), msg)
+	emit("error", fmt.Sprintf("\r%s %s", icon("error"), msg), msg, map[string]any{"event": "bar_finish"})
 }
 
 // Clear finishes and clears the progress bar.
@@ -189,20 +227,29 @@ func NewStageProgress(total int) *StageProgress {
 // The spinner shows [current/total] before the stage name.
 func (sp *StageProgress) Next(name string) *SpinnerHandle {
 	sp.current++
-	s := spinner.New(spinner.CharSets[14], 80*time.Millisecond)
+	_, format, stdout, _ := sink.snapshot()
+	s := spinner.New(spinner.CharSets[currentTheme().SpinnerCharset], 80*time.Millisecond, spinner.WithWriter(stdout))
 	s.Prefix = fmt.Sprintf("[%d/%d] ", sp.current, sp.total)
 	s.Suffix = " " + name
 	s.Color("cyan")
-	s.Start()
+
+	if format == FormatPretty {
+		s.Start()
+	} else {
+		// JSON/logfmt output can't animate, so emit a single start event
+		// instead and let Success/Error/Warn emit the matching finish event.
+		emit("info", "", name, map[string]any{"event": "spinner_start", "stage": sp.current, "total": sp.total})
+	}
 	return &SpinnerHandle{spinner: s, msg: name}
 }
 
 // Skip marks a stage as skipped.
 func (sp *StageProgress) Skip(name string) {
 	sp.current++
-	fmt.Printf("  %s [%d/%d] %s (skipped)\n",
+	pretty := fmt.Sprintf("  %s [%d/%d] %s (skipped)",
 		color.New(color.Faint).Sprint("○"),
 		sp.current, sp.total, name)
+	emit("info", pretty, name, map[string]any{"event": "stage_skip", "stage": sp.current, "total": sp.total})
 }
 
 // Current returns the current stage number (1-indexed).
@@ -1,6 +1,11 @@
 package pintui
 
-import "testing"
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
 
 func TestSpinner(t *testing.T) {
 	t.Run("CreateAndClear", func(t *testing.T) {
@@ -81,3 +86,38 @@ func TestStageProgress(t *testing.T) {
 		}
 	})
 }
+
+func TestSpinnerFinishRespectsUnicodeMode(t *testing.T) {
+	defer resetSink()
+	SetUnicodeMode(UnicodeASCII)
+	defer SetUnicodeMode(UnicodeAuto)
+
+	var out bytes.Buffer
+	SetOutput(&out, &out)
+
+	s := Spinner("Test")
+	s.Success("Done")
+
+	if got := out.String(); !strings.Contains(got, "[OK]") {
+		t.Errorf("Success() output = %q, want ASCII [OK] icon under UnicodeASCII", got)
+	}
+}
+
+func TestStageProgressSkipRespectsOutputAndFormat(t *testing.T) {
+	defer resetSink()
+
+	var out bytes.Buffer
+	SetOutput(&out, &out)
+	SetFormat(FormatJSON)
+
+	stages := NewStageProgress(1)
+	stages.Skip("Stage 1")
+
+	var rec map[string]any
+	if err := json.Unmarshal(out.Bytes(), &rec); err != nil {
+		t.Fatalf("output is not valid JSON: %v (%q)", err, out.String())
+	}
+	if rec["msg"] != "Stage 1" || rec["event"] != "stage_skip" {
+		t.Errorf("rec = %+v, want msg=\"Stage 1\" event=stage_skip", rec)
+	}
+}
@@ -0,0 +1,385 @@
+package pintui
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+// ErrCancelled is returned by Select and MultiSelect when the user cancels
+// with Ctrl-C.
+var ErrCancelled = errors.New("pintui: prompt cancelled")
+
+var (
+	promptCursorStyle   = color.New(color.FgCyan)
+	promptSelectedStyle = color.New(color.FgGreen)
+	promptDimStyle      = color.New(color.Faint)
+)
+
+// promptCursorGlyph returns the glyph Select/MultiSelect use to mark the
+// highlighted option, falling back to ">" when unicodeEnabled() is false.
+func promptCursorGlyph() string {
+	if unicodeEnabled() {
+		return "▸"
+	}
+	return ">"
+}
+
+// promptCheckGlyph returns the glyph Select/MultiSelect use to mark a
+// confirmed or checked option, falling back to "x" when unicodeEnabled()
+// is false.
+func promptCheckGlyph() string {
+	if unicodeEnabled() {
+		return "✓"
+	}
+	return "x"
+}
+
+var promptState = struct {
+	mu             sync.Mutex
+	nonInteractive bool
+}{}
+
+// SetNonInteractive forces prompts to skip reading from stdin. Confirm,
+// Input, and Password return their default value; Select and MultiSelect
+// return an error. Use this to keep CI pipelines deterministic even when
+// stdin happens to be a TTY.
+func SetNonInteractive(v bool) {
+	promptState.mu.Lock()
+	promptState.nonInteractive = v
+	promptState.mu.Unlock()
+}
+
+// nonInteractive reports whether prompts should skip stdin, either because
+// SetNonInteractive(true) was called or because stdin isn't a TTY.
+func nonInteractive() bool {
+	promptState.mu.Lock()
+	forced := promptState.nonInteractive
+	promptState.mu.Unlock()
+	return forced || !term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// Confirm asks a yes/no question and returns the user's answer.
+//
+// On a non-interactive stdin (or after SetNonInteractive(true)), Confirm
+// returns def without prompting.
+//
+// Example:
+//
+//	ok, err := pintui.Confirm("Proceed with deploy?", true)
+//	// Output: ? Proceed with deploy? [Y/n]:
+func Confirm(msg string, def bool) (bool, error) {
+	if nonInteractive() {
+		return def, nil
+	}
+
+	hint := "Y/n"
+	if !def {
+		hint = "y/N"
+	}
+	fmt.Printf("%s %s [%s]: ", promptCursorStyle.Sprint("?"), msg, hint)
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return def, fmt.Errorf("pintui: read confirm: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "":
+		return def, nil
+	case "y", "yes":
+		return true, nil
+	case "n", "no":
+		return false, nil
+	default:
+		return def, nil
+	}
+}
+
+// Input asks for a line of free-form text, offering def when the user
+// enters nothing. If validate is non-nil, Input reports the failure via
+// Error and re-prompts until validate returns nil.
+//
+// Example:
+//
+//	name, err := pintui.Input("Project name", "my-app", nil)
+func Input(msg, def string, validate func(string) error) (string, error) {
+	if nonInteractive() {
+		return def, nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		hint := ""
+		if def != "" {
+			hint = fmt.Sprintf(" [%s]", def)
+		}
+		fmt.Printf("%s %s%s: ", promptCursorStyle.Sprint("?"), msg, hint)
+
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return "", fmt.Errorf("pintui: read input: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			line = def
+		}
+
+		if validate == nil {
+			return line, nil
+		}
+		if verr := validate(line); verr != nil {
+			Error(verr.Error())
+			continue
+		}
+		return line, nil
+	}
+}
+
+// Password asks for a line of text without echoing it to the terminal.
+//
+// Example:
+//
+//	token, err := pintui.Password("API token")
+func Password(msg string) (string, error) {
+	if nonInteractive() {
+		return "", nil
+	}
+
+	fmt.Printf("%s %s: ", promptCursorStyle.Sprint("?"), msg)
+	b, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return "", fmt.Errorf("pintui: read password: %w", err)
+	}
+	return string(b), nil
+}
+
+// promptKey identifies a single keystroke read in raw mode.
+type promptKey rune
+
+const (
+	keyUp promptKey = iota + 0xE000 // private-use range, won't collide with real runes
+	keyDown
+	keyEnter
+	keySpace
+	keyCtrlC
+)
+
+// readKey reads one keystroke from r, decoding arrow-key escape sequences
+// into the synthetic keyUp/keyDown codes.
+func readKey(r io.Reader) (promptKey, error) {
+	buf := make([]byte, 1)
+	if _, err := r.Read(buf); err != nil {
+		return 0, err
+	}
+
+	switch buf[0] {
+	case 3:
+		return keyCtrlC, nil
+	case '\r', '\n':
+		return keyEnter, nil
+	case ' ':
+		return keySpace, nil
+	case 0x1b:
+		return readEscapeSequence(r), nil
+	default:
+		return promptKey(buf[0]), nil
+	}
+}
+
+// escapeSequenceTimeout bounds how long readEscapeSequence waits for the
+// bytes following a lone ESC. Arrow keys arrive as a single burst (ESC,
+// '[', direction), so a short wait is enough to tell them apart from a
+// bare Escape keypress, which sends no further bytes at all.
+const escapeSequenceTimeout = 50 * time.Millisecond
+
+// readEscapeSequence reads the bytes that may follow a lone ESC (0x1b) one
+// at a time, decoding "[A"/"[B" into keyUp/keyDown. A bare ESC with no
+// following bytes (or an unrecognized sequence) is treated as a no-op
+// rather than blocking forever waiting for bytes that may never come.
+func readEscapeSequence(r io.Reader) promptKey {
+	first, ok := readByteWithDeadline(r)
+	if !ok || first != '[' {
+		return 0
+	}
+	second, ok := readByteWithDeadline(r)
+	if !ok {
+		return 0
+	}
+	switch second {
+	case 'A':
+		return keyUp
+	case 'B':
+		return keyDown
+	default:
+		return 0
+	}
+}
+
+// readByteWithDeadline reads a single byte from r, applying
+// escapeSequenceTimeout when r supports read deadlines (as *os.File does
+// for the terminal backing stdin). It reports false on timeout, EOF, or
+// any other error, since for escape-sequence decoding those all mean the
+// same thing: no more bytes are coming.
+func readByteWithDeadline(r io.Reader) (byte, bool) {
+	if d, ok := r.(interface{ SetReadDeadline(time.Time) error }); ok {
+		_ = d.SetReadDeadline(time.Now().Add(escapeSequenceTimeout))
+		defer d.SetReadDeadline(time.Time{})
+	}
+	buf := make([]byte, 1)
+	if _, err := r.Read(buf); err != nil {
+		return 0, false
+	}
+	return buf[0], true
+}
+
+// Select renders options and lets the user pick one with arrow keys (or
+// j/k), confirming with enter. It returns the chosen option's index, or
+// ErrCancelled if the user presses Ctrl-C.
+//
+// Example:
+//
+//	i, err := pintui.Select("Environment", []string{"staging", "production"})
+func Select(msg string, options []string) (int, error) {
+	if len(options) == 0 {
+		return 0, fmt.Errorf("pintui: Select requires at least one option")
+	}
+	if nonInteractive() {
+		return 0, fmt.Errorf("pintui: Select requires an interactive terminal")
+	}
+
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return 0, fmt.Errorf("pintui: enter raw mode: %w", err)
+	}
+	defer term.Restore(fd, oldState)
+
+	cursor := 0
+	render := func() {
+		fmt.Print(msg + "\r\n")
+		for i, opt := range options {
+			if i == cursor {
+				fmt.Printf("%s %s\r\n", promptCursorStyle.Sprint(promptCursorGlyph()), opt)
+			} else {
+				fmt.Printf("  %s\r\n", promptDimStyle.Sprint(opt))
+			}
+		}
+	}
+	clearBlock := func() {
+		fmt.Printf("\033[%dA\033[J", len(options)+1)
+	}
+
+	render()
+	for {
+		key, err := readKey(os.Stdin)
+		if err != nil {
+			return 0, fmt.Errorf("pintui: read key: %w", err)
+		}
+
+		switch key {
+		case keyUp, promptKey('k'):
+			cursor = (cursor - 1 + len(options)) % len(options)
+		case keyDown, promptKey('j'):
+			cursor = (cursor + 1) % len(options)
+		case keyEnter:
+			clearBlock()
+			fmt.Printf("%s %s: %s\n", promptSelectedStyle.Sprint(promptCheckGlyph()), msg, options[cursor])
+			return cursor, nil
+		case keyCtrlC:
+			clearBlock()
+			return 0, ErrCancelled
+		default:
+			continue
+		}
+		clearBlock()
+		render()
+	}
+}
+
+// MultiSelect renders options and lets the user toggle any number of them
+// with space, confirming the selection with enter. It returns the chosen
+// indices in ascending order, or ErrCancelled if the user presses Ctrl-C.
+//
+// Example:
+//
+//	picked, err := pintui.MultiSelect("Features", []string{"auth", "billing", "search"})
+func MultiSelect(msg string, options []string) ([]int, error) {
+	if len(options) == 0 {
+		return nil, fmt.Errorf("pintui: MultiSelect requires at least one option")
+	}
+	if nonInteractive() {
+		return nil, fmt.Errorf("pintui: MultiSelect requires an interactive terminal")
+	}
+
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, fmt.Errorf("pintui: enter raw mode: %w", err)
+	}
+	defer term.Restore(fd, oldState)
+
+	cursor := 0
+	selected := make([]bool, len(options))
+
+	render := func() {
+		fmt.Print(msg + "\r\n")
+		for i, opt := range options {
+			check := " "
+			if selected[i] {
+				check = promptSelectedStyle.Sprint(promptCheckGlyph())
+			}
+			if i == cursor {
+				fmt.Printf("%s [%s] %s\r\n", promptCursorStyle.Sprint(promptCursorGlyph()), check, opt)
+			} else {
+				fmt.Printf("  [%s] %s\r\n", check, promptDimStyle.Sprint(opt))
+			}
+		}
+	}
+	clearBlock := func() {
+		fmt.Printf("\033[%dA\033[J", len(options)+1)
+	}
+
+	render()
+	for {
+		key, err := readKey(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("pintui: read key: %w", err)
+		}
+
+		switch key {
+		case keyUp, promptKey('k'):
+			cursor = (cursor - 1 + len(options)) % len(options)
+		case keyDown, promptKey('j'):
+			cursor = (cursor + 1) % len(options)
+		case keySpace:
+			selected[cursor] = !selected[cursor]
+		case keyEnter:
+			clearBlock()
+			var result []int
+			for i, ok := range selected {
+				if ok {
+					result = append(result, i)
+				}
+			}
+			fmt.Printf("%s %s\n", promptSelectedStyle.Sprint(promptCheckGlyph()), msg)
+			return result, nil
+		case keyCtrlC:
+			clearBlock()
+			return nil, ErrCancelled
+		default:
+			continue
+		}
+		clearBlock()
+		render()
+	}
+}
@@ -0,0 +1,114 @@
+package pintui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPromptNonInteractiveDefaults(t *testing.T) {
+	SetNonInteractive(true)
+	defer SetNonInteractive(false)
+
+	t.Run("Confirm", func(t *testing.T) {
+		ok, err := Confirm("Proceed?", true)
+		if err != nil || !ok {
+			t.Errorf("Confirm() = %v, %v, want true, nil", ok, err)
+		}
+	})
+
+	t.Run("Input", func(t *testing.T) {
+		got, err := Input("Name", "default-name", nil)
+		if err != nil || got != "default-name" {
+			t.Errorf("Input() = %q, %v, want %q, nil", got, err, "default-name")
+		}
+	})
+
+	t.Run("Password", func(t *testing.T) {
+		got, err := Password("Token")
+		if err != nil || got != "" {
+			t.Errorf("Password() = %q, %v, want empty, nil", got, err)
+		}
+	})
+
+	t.Run("Select", func(t *testing.T) {
+		if _, err := Select("Pick", []string{"a", "b"}); err == nil {
+			t.Error("Select() error = nil, want non-nil under SetNonInteractive(true)")
+		}
+	})
+
+	t.Run("MultiSelect", func(t *testing.T) {
+		if _, err := MultiSelect("Pick", []string{"a", "b"}); err == nil {
+			t.Error("MultiSelect() error = nil, want non-nil under SetNonInteractive(true)")
+		}
+	})
+}
+
+func TestPromptGlyphsASCIIFallback(t *testing.T) {
+	SetUnicodeMode(UnicodeASCII)
+	defer SetUnicodeMode(UnicodeAuto)
+
+	if g := promptCursorGlyph(); g != ">" {
+		t.Errorf("promptCursorGlyph() under UnicodeASCII = %q, want %q", g, ">")
+	}
+	if g := promptCheckGlyph(); g != "x" {
+		t.Errorf("promptCheckGlyph() under UnicodeASCII = %q, want %q", g, "x")
+	}
+}
+
+func TestReadKeyDecodesArrowKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  promptKey
+	}{
+		{"up", "\x1b[A", keyUp},
+		{"down", "\x1b[B", keyDown},
+		{"enter", "\r", keyEnter},
+		{"space", " ", keySpace},
+		{"ctrlC", "\x03", keyCtrlC},
+		{"plain rune", "j", promptKey('j')},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readKey(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("readKey(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("readKey(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadKeyBareEscapeIsNoOp(t *testing.T) {
+	// A lone ESC with nothing following it (strings.Reader reports EOF on
+	// the next read, same as a real terminal with no more bytes queued)
+	// must not hang and must not be mistaken for an arrow key.
+	got, err := readKey(strings.NewReader("\x1b"))
+	if err != nil {
+		t.Fatalf("readKey(ESC) error = %v", err)
+	}
+	if got != 0 {
+		t.Errorf("readKey(ESC) = %v, want 0 (no-op)", got)
+	}
+}
+
+func TestReadKeyUnrecognizedEscapeSequenceIsNoOp(t *testing.T) {
+	got, err := readKey(strings.NewReader("\x1b[Z"))
+	if err != nil {
+		t.Fatalf("readKey(ESC[Z) error = %v", err)
+	}
+	if got != 0 {
+		t.Errorf("readKey(ESC[Z) = %v, want 0 (no-op)", got)
+	}
+}
+
+func TestSelectRequiresOptions(t *testing.T) {
+	if _, err := Select("Pick", nil); err == nil {
+		t.Error("Select(nil) error = nil, want non-nil")
+	}
+	if _, err := MultiSelect("Pick", nil); err == nil {
+		t.Error("MultiSelect(nil) error = nil, want non-nil")
+	}
+}
@@ -0,0 +1,194 @@
+package pintui
+
+import (
+	"fmt"
+	"math/bits"
+
+	"golang.org/x/text/message"
+)
+
+// Units selects the base and suffixes a SizeFormatter scales bytes by.
+type Units int
+
+const (
+	// UnitsCompat uses 1024-based math labeled with the SI-looking
+	// suffixes KB/MB/GB/TB/PB/EB, matching HumanSize below the TB tier.
+	// HumanSize itself predates SizeFormatter and is frozen at 2 decimal
+	// digits for TB and no PB/EB tiers at all (see its doc comment), so
+	// FormatSize/SizeFormatter and HumanSize intentionally diverge at and
+	// above TB — use SizeFormatter{Units: UnitsCompat} directly rather
+	// than HumanSize if you need PB/EB or uniform precision.
+	UnitsCompat Units = iota
+	// UnitsIEC uses 1024-based math with the correct IEC suffixes
+	// KiB/MiB/GiB/TiB.
+	UnitsIEC
+	// UnitsSI uses 1000-based math with SI suffixes kB/MB/GB/TB.
+	UnitsSI
+)
+
+type sizeTier struct {
+	threshold uint64
+	divisor   uint64
+	suffix    string
+}
+
+func (u Units) tiers() []sizeTier {
+	switch u {
+	case UnitsSI:
+		const (
+			k = 1000
+			m = k * 1000
+			g = m * 1000
+			t = g * 1000
+			p = t * 1000
+			e = p * 1000
+		)
+		return []sizeTier{
+			{e, e, "EB"}, {p, p, "PB"}, {t, t, "TB"}, {g, g, "GB"}, {m, m, "MB"}, {k, k, "kB"},
+		}
+	case UnitsIEC:
+		return []sizeTier{
+			{EB, EB, "EiB"}, {PB, PB, "PiB"}, {TB, TB, "TiB"}, {GB, GB, "GiB"}, {MB, MB, "MiB"}, {KB, KB, "KiB"},
+		}
+	default: // UnitsCompat
+		return []sizeTier{
+			{EB, EB, "EB"}, {PB, PB, "PB"}, {TB, TB, "TB"}, {GB, GB, "GB"}, {MB, MB, "MB"}, {KB, KB, "KB"},
+		}
+	}
+}
+
+// SizeFormatter formats byte counts as human-readable strings with a
+// chosen Units base, decimal precision, and optional locale.
+//
+// Example:
+//
+//	f := pintui.NewSizeFormatter(pintui.UnitsIEC)
+//	f.Format(1536) // "1.5 KiB"
+type SizeFormatter struct {
+	units     Units
+	precision int
+	space     bool
+	printer   *message.Printer
+}
+
+// NewSizeFormatter creates a SizeFormatter for the given Units, with the
+// same default precision and spacing as FormatSize.
+func NewSizeFormatter(units Units) *SizeFormatter {
+	return &SizeFormatter{units: units, precision: 1, space: true}
+}
+
+// SizeOption configures a SizeFormatter or a single FormatSize call.
+type SizeOption func(*SizeFormatter)
+
+// WithPrecision sets the number of digits after the decimal point.
+func WithPrecision(precision int) SizeOption {
+	return func(f *SizeFormatter) { f.precision = precision }
+}
+
+// WithSpace controls whether a space separates the number from its unit
+// suffix (true, the default).
+func WithSpace(space bool) SizeOption {
+	return func(f *SizeFormatter) { f.space = space }
+}
+
+// WithUnits selects the Units base: UnitsCompat (the default), UnitsIEC,
+// or UnitsSI.
+func WithUnits(units Units) SizeOption {
+	return func(f *SizeFormatter) { f.units = units }
+}
+
+// WithLocale formats the whole-number part with the thousands separators
+// of p's locale (e.g. "1,536" in en-US, "1.536" in de-DE).
+func WithLocale(p *message.Printer) SizeOption {
+	return func(f *SizeFormatter) { f.printer = p }
+}
+
+// Apply updates the formatter with the given options.
+func (f *SizeFormatter) Apply(opts ...SizeOption) {
+	for _, opt := range opts {
+		opt(f)
+	}
+}
+
+// Format renders bytes using the formatter's configured units, precision,
+// spacing, and locale.
+func (f *SizeFormatter) Format(bytes uint64) string {
+	sep := " "
+	if !f.space {
+		sep = ""
+	}
+
+	for _, tier := range f.units.tiers() {
+		if bytes >= tier.threshold {
+			whole, frac := divmodDecimal(bytes, tier.divisor, f.precision)
+			return f.formatWhole(whole) + fracSuffix(frac, f.precision) + sep + tier.suffix
+		}
+	}
+	return f.formatWhole(bytes) + sep + "B"
+}
+
+func (f *SizeFormatter) formatWhole(whole uint64) string {
+	if f.printer != nil {
+		return f.printer.Sprintf("%d", whole)
+	}
+	return fmt.Sprintf("%d", whole)
+}
+
+func fracSuffix(frac uint64, precision int) string {
+	if precision <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(".%0*d", precision, frac)
+}
+
+// divmodDecimal divides bytes by divisor, returning the integer quotient
+// and a `precision`-digit fractional part of the remainder, rounded half
+// up at the last digit (so e.g. 1997/1024 at precision 1 is "2.0", not
+// "1.9"). It uses math/bits' 128-bit multiply/divide so the rounding
+// stays exact even for values too large for float64 to represent
+// precisely.
+func divmodDecimal(bytes, divisor uint64, precision int) (whole, frac uint64) {
+	whole = bytes / divisor
+	remainder := bytes % divisor
+	if precision <= 0 {
+		return whole, 0
+	}
+	if precision > 18 {
+		precision = 18 // guards against uint64 overflow in the scale below
+	}
+
+	scale := uint64(1)
+	for i := 0; i < precision; i++ {
+		scale *= 10
+	}
+
+	// Carry the division out one extra digit so that digit can round the
+	// last one, instead of just truncating it.
+	hi, lo := bits.Mul64(remainder, scale*10)
+	extended, _ := bits.Div64(hi, lo, divisor)
+	frac = extended / 10
+	if extended%10 >= 5 {
+		frac++
+	}
+	if frac == scale {
+		frac = 0
+		whole++
+	}
+	return whole, frac
+}
+
+// FormatSize formats bytes as a human-readable size string. Without
+// options it mirrors HumanSize's 1.0 KB/MB/GB output (UnitsCompat); at
+// and above TB it keeps 1 decimal digit and scales on to PB/EB, where
+// HumanSize does not (see UnitsCompat). Pass WithUnits(UnitsIEC) or
+// WithUnits(UnitsSI) for the correctly labeled variants.
+//
+// Example:
+//
+//	pintui.FormatSize(1536, pintui.WithUnits(pintui.UnitsIEC)) // "1.5 KiB"
+//	pintui.FormatSize(1536, pintui.WithUnits(pintui.UnitsSI))  // "1.5 kB"
+func FormatSize(bytes uint64, opts ...SizeOption) string {
+	f := &SizeFormatter{units: UnitsCompat, precision: 1, space: true}
+	f.Apply(opts...)
+	return f.Format(bytes)
+}
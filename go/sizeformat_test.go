@@ -0,0 +1,89 @@
+package pintui
+
+import "testing"
+
+func TestFormatSizeUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		bytes    uint64
+		opts     []SizeOption
+		expected string
+	}{
+		{"compat KB", 1536, nil, "1.5 KB"},
+		{"iec KiB", 1536, []SizeOption{WithUnits(UnitsIEC)}, "1.5 KiB"},
+		{"si kB", 1500, []SizeOption{WithUnits(UnitsSI)}, "1.5 kB"},
+		{"iec no space", 1536, []SizeOption{WithUnits(UnitsIEC), WithSpace(false)}, "1.5KiB"},
+		{"precision 0", 1536, []SizeOption{WithPrecision(0)}, "1 KB"},
+		{"bytes below smallest tier", 512, []SizeOption{WithUnits(UnitsIEC)}, "512 B"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatSize(tt.bytes, tt.opts...)
+			if got != tt.expected {
+				t.Errorf("FormatSize(%d) = %q, want %q", tt.bytes, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatSizeRoundsHalfUp(t *testing.T) {
+	// 1997/1024 = 1.950..., which should round up to match HumanSize(1997)
+	// ("2.0 KB"), not truncate down to "1.9 KB".
+	tests := []struct {
+		bytes    uint64
+		expected string
+	}{
+		{1997, "2.0 KB"},
+		{1998, "2.0 KB"},
+		{1999, "2.0 KB"},
+		{2047, "2.0 KB"},
+	}
+	for _, tt := range tests {
+		if got := FormatSize(tt.bytes); got != tt.expected {
+			t.Errorf("FormatSize(%d) = %q, want %q", tt.bytes, got, tt.expected)
+		}
+		if got := HumanSize(tt.bytes); got != tt.expected {
+			t.Errorf("HumanSize(%d) = %q, want %q", tt.bytes, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatSizeLargeValuesExactFraction(t *testing.T) {
+	// 9 PB + half a PB, comfortably above the ~9 PB float64 precision
+	// concern called out in the request.
+	bytes := 9*PB + PB/2
+	got := FormatSize(bytes, WithUnits(UnitsIEC), WithPrecision(4))
+	if got != "9.5000 PiB" {
+		t.Errorf("FormatSize(9.5 PiB) = %q, want %q", got, "9.5000 PiB")
+	}
+}
+
+func TestFormatSizeDivergesFromHumanSizeAtTB(t *testing.T) {
+	// HumanSize is frozen at its historical output (2 decimal digits at
+	// TB, no PB/EB tiers); FormatSize/UnitsCompat uses uniform precision
+	// and keeps scaling past TB. Pinned here so the two don't silently
+	// drift further apart than this known, documented gap.
+	const tb = 3835405795328 // ~3.49 TiB
+	if got, want := HumanSize(tb), "3.49 TB"; got != want {
+		t.Errorf("HumanSize(%d) = %q, want %q", tb, got, want)
+	}
+	if got, want := FormatSize(tb), "3.5 TB"; got != want {
+		t.Errorf("FormatSize(%d) = %q, want %q", tb, got, want)
+	}
+
+	twoPB := 2 * PB
+	if got, want := HumanSize(twoPB), "2048.00 TB"; got != want {
+		t.Errorf("HumanSize(2*PB) = %q, want %q", got, want)
+	}
+	if got, want := FormatSize(twoPB), "2.0 PB"; got != want {
+		t.Errorf("FormatSize(2*PB) = %q, want %q", got, want)
+	}
+}
+
+func TestNewSizeFormatter(t *testing.T) {
+	f := NewSizeFormatter(UnitsSI)
+	if got := f.Format(1_000_000); got != "1.0 MB" {
+		t.Errorf("Format(1e6) = %q, want %q", got, "1.0 MB")
+	}
+}
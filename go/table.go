@@ -0,0 +1,463 @@
+package pintui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+// Alignment controls how a Table column's cells are padded.
+type Alignment int
+
+const (
+	// AlignLeft left-aligns cells, padding them on the right to the
+	// column width (the default).
+	AlignLeft Alignment = iota
+	// AlignCenter centers cells within the column width.
+	AlignCenter
+	// AlignRight right-aligns cells, padding them on the left to the
+	// column width.
+	AlignRight
+)
+
+// TableStyle selects how a Table is drawn.
+type TableStyle int
+
+const (
+	// TableStylePlain renders space-separated columns with a faint
+	// divider under the header, matching the KV/Divider aesthetic.
+	TableStylePlain TableStyle = iota
+	// TableStyleBox renders bordered columns using Unicode box-drawing
+	// characters.
+	TableStyleBox
+	// TableStyleMarkdown renders a GitHub-flavored Markdown table,
+	// suitable for piping into docs.
+	TableStyleMarkdown
+)
+
+const tableMinColWidth = 3
+
+var (
+	tableHeaderStyle = color.New(color.Faint)
+	tableBorderStyle = color.New(color.Faint)
+)
+
+// Table renders bordered or borderless tabular data using pintui's color
+// palette. It complements KV for data that's naturally columnar.
+//
+// Example:
+//
+//	t := pintui.NewTable("Name", "Size", "Modified")
+//	t.Row("foo.txt", pintui.HumanSize(1234), "2m ago")
+//	t.SetAlignment(pintui.AlignLeft, pintui.AlignRight, pintui.AlignRight)
+//	t.Print()
+type Table struct {
+	headers []string
+	rows    [][]string
+	align   []Alignment
+	style   TableStyle
+	wrap    bool
+}
+
+// NewTable creates a table with the given column headers.
+func NewTable(headers ...string) *Table {
+	return &Table{
+		headers: headers,
+		align:   make([]Alignment, len(headers)),
+	}
+}
+
+// Row appends a row of cells. Missing trailing cells render empty; extra
+// cells beyond the header count are ignored.
+func (t *Table) Row(cells ...string) *Table {
+	t.rows = append(t.rows, cells)
+	return t
+}
+
+// SetAlignment sets the per-column alignment, in header order.
+func (t *Table) SetAlignment(aligns ...Alignment) *Table {
+	copy(t.align, aligns)
+	return t
+}
+
+// SetStyle selects the rendering style: TableStylePlain (the default),
+// TableStyleBox, or TableStyleMarkdown.
+func (t *Table) SetStyle(style TableStyle) *Table {
+	t.style = style
+	return t
+}
+
+// SetWrap controls how cells wider than their column are handled when the
+// table is shrunk to fit the terminal width: wrap onto extra output lines,
+// padding sibling columns in that row to match (true), or truncate with an
+// ellipsis (false, the default). TableStyleMarkdown cells always truncate,
+// since Markdown tables have no multi-line cell syntax.
+func (t *Table) SetWrap(wrap bool) *Table {
+	t.wrap = wrap
+	return t
+}
+
+func (t *Table) cell(row, col int) string {
+	if col >= len(t.rows[row]) {
+		return ""
+	}
+	return t.rows[row][col]
+}
+
+// columnWidths returns each column's natural (unconstrained) width, measured
+// in terminal columns so CJK and other wide runes don't throw off alignment.
+func (t *Table) columnWidths() []int {
+	widths := make([]int, len(t.headers))
+	for i, h := range t.headers {
+		widths[i] = displayWidth(h)
+	}
+	for r := range t.rows {
+		for c := range t.headers {
+			if w := displayWidth(t.cell(r, c)); w > widths[c] {
+				widths[c] = w
+			}
+		}
+	}
+	return widths
+}
+
+// fitWidths shrinks widths so the table fits within maxWidth, taking the
+// width off the widest columns first. maxWidth <= 0 disables fitting.
+func fitWidths(widths []int, sepWidth, maxWidth int) []int {
+	if maxWidth <= 0 {
+		return widths
+	}
+	total := func(ws []int) int {
+		sum := sepWidth * (len(ws) - 1)
+		for _, w := range ws {
+			sum += w
+		}
+		return sum
+	}
+
+	fitted := append([]int(nil), widths...)
+	for total(fitted) > maxWidth {
+		widest := 0
+		for i, w := range fitted {
+			if w > fitted[widest] {
+				widest = i
+			}
+		}
+		if fitted[widest] <= tableMinColWidth {
+			break
+		}
+		fitted[widest]--
+	}
+	return fitted
+}
+
+func truncateCell(s string, width int) string {
+	if displayWidth(s) <= width {
+		return s
+	}
+	if width <= 3 {
+		return strings.Repeat(".", width)
+	}
+
+	var b strings.Builder
+	w := 0
+	for _, r := range s {
+		rw := 1
+		if isWideRune(r) {
+			rw = 2
+		}
+		if w+rw > width-3 {
+			break
+		}
+		b.WriteRune(r)
+		w += rw
+	}
+	return b.String() + "..."
+}
+
+// wrapCell splits s into lines of at most width display columns each,
+// breaking on spaces where possible. A single word longer than width is
+// hard-broken mid-word rather than left overflowing.
+func wrapCell(s string, width int) []string {
+	if width <= 0 || displayWidth(s) <= width {
+		return []string{s}
+	}
+
+	var lines []string
+	var line strings.Builder
+	lineWidth := 0
+	for _, word := range strings.Fields(s) {
+		ww := displayWidth(word)
+		for ww > width {
+			// The word alone doesn't fit in a line; hard-break it.
+			if lineWidth > 0 {
+				lines = append(lines, line.String())
+				line.Reset()
+				lineWidth = 0
+			}
+			head, rest := breakWord(word, width)
+			lines = append(lines, head)
+			word, ww = rest, displayWidth(rest)
+		}
+		switch {
+		case lineWidth == 0:
+			line.WriteString(word)
+			lineWidth = ww
+		case lineWidth+1+ww <= width:
+			line.WriteString(" " + word)
+			lineWidth += 1 + ww
+		default:
+			lines = append(lines, line.String())
+			line.Reset()
+			line.WriteString(word)
+			lineWidth = ww
+		}
+	}
+	if lineWidth > 0 || len(lines) == 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// breakWord splits word into a head that fits within width display columns
+// and the remaining tail.
+func breakWord(word string, width int) (head, rest string) {
+	w := 0
+	runes := []rune(word)
+	i := 0
+	for ; i < len(runes); i++ {
+		rw := 1
+		if isWideRune(runes[i]) {
+			rw = 2
+		}
+		if w+rw > width {
+			break
+		}
+		w += rw
+	}
+	if i == 0 {
+		i = 1 // always make progress, even if a single wide rune exceeds width
+	}
+	return string(runes[:i]), string(runes[i:])
+}
+
+func padCell(s string, width int, align Alignment) string {
+	gap := width - displayWidth(s)
+	if gap <= 0 {
+		return s
+	}
+	switch align {
+	case AlignRight:
+		return strings.Repeat(" ", gap) + s
+	case AlignCenter:
+		left := gap / 2
+		return strings.Repeat(" ", left) + s + strings.Repeat(" ", gap-left)
+	default:
+		return s + strings.Repeat(" ", gap)
+	}
+}
+
+// terminalWidth returns the current stdout width, or 0 if it can't be
+// determined (e.g. stdout isn't a TTY).
+func terminalWidth() int {
+	w, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return 0
+	}
+	return w
+}
+
+// Print renders the table to stdout.
+func (t *Table) Print() {
+	fmt.Print(t.String())
+}
+
+// String renders the table as a string, without printing it.
+func (t *Table) String() string {
+	if len(t.headers) == 0 {
+		return ""
+	}
+
+	widths := t.columnWidths()
+	sepWidth := 3 // " | " between Markdown columns; plain style uses two spaces
+	widths = fitWidths(widths, sepWidth, terminalWidth())
+
+	switch t.style {
+	case TableStyleBox:
+		return t.renderBox(widths)
+	case TableStyleMarkdown:
+		return t.renderMarkdown(widths)
+	default:
+		return t.renderPlain(widths)
+	}
+}
+
+// formatRow renders cells into one output line per column, truncating
+// anything wider than its column. Used directly wherever wrapping doesn't
+// apply (TableStyleMarkdown) and as the single-line fallback within
+// rowLines when SetWrap(false) is in effect (the default).
+func (t *Table) formatRow(cells []string, widths []int) []string {
+	out := make([]string, len(widths))
+	for c := range widths {
+		cell := ""
+		if c < len(cells) {
+			cell = cells[c]
+		}
+		cell = truncateCell(cell, widths[c])
+		align := AlignLeft
+		if c < len(t.align) {
+			align = t.align[c]
+		}
+		out[c] = padCell(cell, widths[c], align)
+	}
+	return out
+}
+
+// rowLines renders cells into one or more output lines. Under SetWrap(true)
+// a cell wider than its column wraps onto extra lines instead of
+// truncating, and sibling columns in those extra lines are padded blank so
+// the row's column alignment holds.
+func (t *Table) rowLines(cells []string, widths []int) [][]string {
+	if !t.wrap {
+		return [][]string{t.formatRow(cells, widths)}
+	}
+
+	columnLines := make([][]string, len(widths))
+	maxLines := 1
+	for c := range widths {
+		cell := ""
+		if c < len(cells) {
+			cell = cells[c]
+		}
+		columnLines[c] = wrapCell(cell, widths[c])
+		if len(columnLines[c]) > maxLines {
+			maxLines = len(columnLines[c])
+		}
+	}
+
+	lines := make([][]string, maxLines)
+	for i := range lines {
+		line := make([]string, len(widths))
+		for c := range widths {
+			text := ""
+			if i < len(columnLines[c]) {
+				text = columnLines[c][i]
+			}
+			align := AlignLeft
+			if c < len(t.align) {
+				align = t.align[c]
+			}
+			line[c] = padCell(text, widths[c], align)
+		}
+		lines[i] = line
+	}
+	return lines
+}
+
+func (t *Table) renderPlain(widths []int) string {
+	var b strings.Builder
+	for _, line := range t.rowLines(t.headers, widths) {
+		b.WriteString(tableHeaderStyle.Sprint(strings.Join(line, "  ")))
+		b.WriteString("\n")
+	}
+
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	total += 2 * (len(widths) - 1)
+	b.WriteString(tableBorderStyle.Sprint(strings.Repeat(dividerRune(), total)))
+	b.WriteString("\n")
+
+	for _, row := range t.rows {
+		for _, line := range t.rowLines(row, widths) {
+			b.WriteString(strings.Join(line, "  "))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func (t *Table) renderBox(widths []int) string {
+	glyphs := tableBoxGlyphs()
+	var b strings.Builder
+	border := func(left, mid, right string) {
+		b.WriteString(tableBorderStyle.Sprint(left))
+		for i, w := range widths {
+			b.WriteString(tableBorderStyle.Sprint(strings.Repeat(glyphs.horizontal, w+2)))
+			if i < len(widths)-1 {
+				b.WriteString(tableBorderStyle.Sprint(mid))
+			}
+		}
+		b.WriteString(tableBorderStyle.Sprint(right))
+		b.WriteString("\n")
+	}
+	dataRow := func(cells []string) {
+		for _, line := range t.rowLines(cells, widths) {
+			b.WriteString(tableBorderStyle.Sprint(glyphs.vertical))
+			for _, cell := range line {
+				b.WriteString(" " + cell + " ")
+				b.WriteString(tableBorderStyle.Sprint(glyphs.vertical))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	border(glyphs.topLeft, glyphs.topMid, glyphs.topRight)
+	dataRow(t.headers)
+	border(glyphs.midLeft, glyphs.midMid, glyphs.midRight)
+	for _, row := range t.rows {
+		dataRow(row)
+	}
+	border(glyphs.botLeft, glyphs.botMid, glyphs.botRight)
+	return b.String()
+}
+
+// boxGlyphs holds the border characters renderBox draws a TableStyleBox
+// table with.
+type boxGlyphs struct {
+	topLeft, topMid, topRight string
+	midLeft, midMid, midRight string
+	botLeft, botMid, botRight string
+	horizontal, vertical      string
+}
+
+// tableBoxGlyphs returns the Unicode box-drawing glyphs, falling back to
+// a plain ASCII set ("+", "-", "|") when unicodeEnabled() is false.
+func tableBoxGlyphs() boxGlyphs {
+	if unicodeEnabled() {
+		return boxGlyphs{
+			topLeft: "┌", topMid: "┬", topRight: "┐",
+			midLeft: "├", midMid: "┼", midRight: "┤",
+			botLeft: "└", botMid: "┴", botRight: "┘",
+			horizontal: "─", vertical: "│",
+		}
+	}
+	return boxGlyphs{
+		topLeft: "+", topMid: "+", topRight: "+",
+		midLeft: "+", midMid: "+", midRight: "+",
+		botLeft: "+", botMid: "+", botRight: "+",
+		horizontal: "-", vertical: "|",
+	}
+}
+
+func (t *Table) renderMarkdown(widths []int) string {
+	var b strings.Builder
+	row := func(cells []string) {
+		b.WriteString("| " + strings.Join(t.formatRow(cells, widths), " | ") + " |\n")
+	}
+
+	row(t.headers)
+	seps := make([]string, len(widths))
+	for i, w := range widths {
+		seps[i] = strings.Repeat("-", w)
+	}
+	b.WriteString("| " + strings.Join(seps, " | ") + " |\n")
+	for _, r := range t.rows {
+		row(r)
+	}
+	return b.String()
+}
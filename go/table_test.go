@@ -0,0 +1,154 @@
+package pintui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTablePlain(t *testing.T) {
+	tbl := NewTable("Name", "Size")
+	tbl.Row("foo.txt", "1.0 KB")
+	tbl.Row("bar.txt", "2.0 KB")
+	tbl.SetAlignment(AlignLeft, AlignRight)
+
+	out := tbl.String()
+	if !strings.Contains(out, "Name") || !strings.Contains(out, "foo.txt") {
+		t.Errorf("String() = %q, missing header/row content", out)
+	}
+}
+
+func TestTableBoxAndMarkdown(t *testing.T) {
+	tbl := NewTable("A", "B")
+	tbl.Row("1", "2")
+
+	box := tbl.SetStyle(TableStyleBox).String()
+	if !strings.Contains(box, "┌") || !strings.Contains(box, "┘") {
+		t.Errorf("box style missing border characters: %q", box)
+	}
+
+	md := NewTable("A", "B")
+	md.Row("1", "2")
+	md.SetStyle(TableStyleMarkdown)
+	out := md.String()
+	if !strings.HasPrefix(out, "| A | B |") {
+		t.Errorf("markdown style = %q, want header row prefix", out)
+	}
+}
+
+func TestTableBoxASCIIFallback(t *testing.T) {
+	SetUnicodeMode(UnicodeASCII)
+	defer SetUnicodeMode(UnicodeAuto)
+
+	tbl := NewTable("A", "B")
+	tbl.Row("1", "2")
+	box := tbl.SetStyle(TableStyleBox).String()
+
+	if strings.ContainsAny(box, "┌┬┐│├┼┤└┴┘─") {
+		t.Errorf("box style under UnicodeASCII = %q, want no Unicode border characters", box)
+	}
+	if !strings.Contains(box, "+") || !strings.Contains(box, "|") {
+		t.Errorf("box style under UnicodeASCII = %q, want ASCII border characters", box)
+	}
+}
+
+func TestTableTruncatesLongCells(t *testing.T) {
+	got := truncateCell("a very long value that overflows", 10)
+	if got != "a very ..." {
+		t.Errorf("truncateCell() = %q, want %q", got, "a very ...")
+	}
+}
+
+func TestTableColumnWidthUsesDisplayWidth(t *testing.T) {
+	tbl := NewTable("名前", "Size")
+	tbl.Row("foo.txt", "1.0 KB")
+
+	widths := tbl.columnWidths()
+	// "名前" is 2 CJK runes (displayWidth 4), still narrower than "foo.txt"
+	// (7 narrow runes), so the column is 7 wide.
+	if widths[0] != 7 {
+		t.Errorf("widths[0] = %d, want 7 (max of displayWidth(\"名前\")=4 and displayWidth(\"foo.txt\")=7)", widths[0])
+	}
+
+	out := tbl.String()
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	if len(lines) < 2 {
+		t.Fatalf("String() = %q, want at least 2 lines", out)
+	}
+	if displayWidth(lines[0]) != displayWidth(lines[1]) {
+		t.Errorf("header/divider width mismatch: header=%d divider=%d (%q / %q)", displayWidth(lines[0]), displayWidth(lines[1]), lines[0], lines[1])
+	}
+}
+
+func TestTableTruncatesCJKCellWithoutSplittingRunes(t *testing.T) {
+	got := truncateCell("日本語ヘッダーです", 8)
+	if !strings.HasSuffix(got, "...") {
+		t.Errorf("truncateCell() = %q, want a %q suffix", got, "...")
+	}
+	if displayWidth(got) > 8 {
+		t.Errorf("truncateCell() = %q, displayWidth %d exceeds requested width 8", got, displayWidth(got))
+	}
+}
+
+func TestWrapCellBreaksOnWordBoundaries(t *testing.T) {
+	got := wrapCell("a fairly long description", 12)
+	want := []string{"a fairly", "long", "description"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrapCell() = %q, want %q", got, want)
+	}
+}
+
+func TestWrapCellHardBreaksOverlongWord(t *testing.T) {
+	got := wrapCell("supercalifragilisticexpialidocious", 10)
+	for _, line := range got {
+		if displayWidth(line) > 10 {
+			t.Errorf("wrapCell() line %q exceeds width 10", line)
+		}
+	}
+	if strings.Join(got, "") != "supercalifragilisticexpialidocious" {
+		t.Errorf("wrapCell() = %q, lost characters from the original word", got)
+	}
+}
+
+func TestWrapCellFitsUnchanged(t *testing.T) {
+	got := wrapCell("short", 10)
+	if !reflect.DeepEqual(got, []string{"short"}) {
+		t.Errorf("wrapCell() = %q, want [\"short\"]", got)
+	}
+}
+
+func TestTableRowLinesWrapPadsSiblingColumns(t *testing.T) {
+	tbl := NewTable("Name", "Description")
+	tbl.SetWrap(true)
+	widths := []int{10, 12}
+
+	lines := tbl.rowLines([]string{"foo", "a fairly long description"}, widths)
+	if len(lines) != 3 {
+		t.Fatalf("rowLines() produced %d lines, want 3: %v", len(lines), lines)
+	}
+	for i, line := range lines {
+		if displayWidth(line[0]) != widths[0] || displayWidth(line[1]) != widths[1] {
+			t.Errorf("line %d = %q, want each cell padded to its column width", i, line)
+		}
+	}
+	if strings.TrimSpace(lines[1][0]) != "" {
+		t.Errorf("Name column on wrapped line 1 = %q, want blank padding", lines[1][0])
+	}
+}
+
+func TestTableNoWrapStillTruncates(t *testing.T) {
+	tbl := NewTable("Name", "Description")
+	widths := []int{10, 10}
+
+	line := tbl.formatRow([]string{"foo", "a fairly long description"}, widths)
+	if !strings.Contains(line[1], "...") {
+		t.Errorf("formatRow() without SetWrap = %q, want truncation ellipsis", line)
+	}
+}
+
+func TestTableEmptyHeaders(t *testing.T) {
+	tbl := NewTable()
+	if tbl.String() != "" {
+		t.Errorf("String() with no headers = %q, want empty", tbl.String())
+	}
+}
@@ -0,0 +1,259 @@
+package pintui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"gopkg.in/yaml.v3"
+)
+
+// Theme holds every styled token pintui renders: icons, colors, the divider
+// rune, indent width, and bar glyphs. Go and sibling implementations in
+// other languages are meant to load the same tokens file so a CLI's visual
+// language stays consistent regardless of implementation language.
+type Theme struct {
+	Name string `json:"name" yaml:"name"`
+
+	Icons struct {
+		Info    string `json:"info" yaml:"info"`
+		Success string `json:"success" yaml:"success"`
+		Warn    string `json:"warn" yaml:"warn"`
+		Error   string `json:"error" yaml:"error"`
+	} `json:"icons" yaml:"icons"`
+
+	Colors struct {
+		Info    string `json:"info" yaml:"info"`
+		Success string `json:"success" yaml:"success"`
+		Warn    string `json:"warn" yaml:"warn"`
+		Error   string `json:"error" yaml:"error"`
+		Header  string `json:"header" yaml:"header"`
+		Dim     string `json:"dim" yaml:"dim"`
+	} `json:"colors" yaml:"colors"`
+
+	Divider     string `json:"divider" yaml:"divider"`
+	IndentWidth int    `json:"indentWidth" yaml:"indentWidth"`
+
+	Bar struct {
+		Saucer     string `json:"saucer" yaml:"saucer"`
+		SaucerHead string `json:"saucerHead" yaml:"saucerHead"`
+		Padding    string `json:"padding" yaml:"padding"`
+	} `json:"bar" yaml:"bar"`
+
+	// SpinnerCharset is a CharSet index into github.com/briandowns/spinner.
+	SpinnerCharset int `json:"spinnerCharset" yaml:"spinnerCharset"`
+}
+
+// DefaultTheme returns the built-in theme pintui ships with: the colors and
+// glyphs documented in the package doc comment.
+func DefaultTheme() Theme {
+	t := Theme{Name: "default", IndentWidth: 2, SpinnerCharset: 14}
+	t.Icons.Info, t.Icons.Success, t.Icons.Warn, t.Icons.Error = "ℹ", "✓", "⚠", "✗"
+	t.Colors.Info, t.Colors.Success, t.Colors.Warn, t.Colors.Error = "blue", "green", "yellow", "red"
+	t.Colors.Header, t.Colors.Dim = "cyan", "faint"
+	t.Divider = "─"
+	t.Bar.Saucer, t.Bar.SaucerHead, t.Bar.Padding = "━", "╸", "─"
+	return t
+}
+
+// MonochromeTheme returns the default glyphs with every color stripped,
+// for terminals or logs that should never emit ANSI escapes.
+func MonochromeTheme() Theme {
+	t := DefaultTheme()
+	t.Name = "monochrome"
+	t.Colors.Info, t.Colors.Success, t.Colors.Warn, t.Colors.Error = "", "", "", ""
+	t.Colors.Header, t.Colors.Dim = "", ""
+	return t
+}
+
+var themeState = struct {
+	mu      sync.Mutex
+	current Theme
+	named   map[string]Theme
+}{current: DefaultTheme(), named: map[string]Theme{}}
+
+func init() {
+	RegisterTheme("default", DefaultTheme())
+	RegisterTheme("monochrome", MonochromeTheme())
+	if name := os.Getenv("PINTUI_THEME"); name != "" {
+		themeState.mu.Lock()
+		if t, ok := themeState.named[name]; ok {
+			themeState.current = t
+		}
+		themeState.mu.Unlock()
+	}
+}
+
+// RegisterTheme adds t to the theme registry under name, making it
+// selectable via the PINTUI_THEME environment variable at startup.
+func RegisterTheme(name string, t Theme) {
+	themeState.mu.Lock()
+	defer themeState.mu.Unlock()
+	themeState.named[name] = t
+	if env := os.Getenv("PINTUI_THEME"); env != "" && env == name {
+		themeState.current = t
+	}
+}
+
+// currentTheme returns the active theme.
+func currentTheme() Theme {
+	themeState.mu.Lock()
+	defer themeState.mu.Unlock()
+	return themeState.current
+}
+
+// setCurrentTheme rebinds the package globals driven by the theme layer
+// (icons, colors, divider, indent width, bar glyphs, spinner charset).
+func setCurrentTheme(t Theme) {
+	themeState.mu.Lock()
+	themeState.current = t
+	themeState.mu.Unlock()
+}
+
+// LoadTheme reads a JSON or YAML design-tokens file (by extension) and
+// makes it the active theme.
+func LoadTheme(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("pintui: load theme: %w", err)
+	}
+	t, err := decodeTheme(path, data)
+	if err != nil {
+		return err
+	}
+	setCurrentTheme(t)
+	return nil
+}
+
+// LoadThemeFS reads a JSON or YAML design-tokens file named name from fsys
+// and makes it the active theme. Use this to embed a shared tokens file
+// with go:embed.
+func LoadThemeFS(fsys fs.FS, name string) error {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return fmt.Errorf("pintui: load theme: %w", err)
+	}
+	t, err := decodeTheme(name, data)
+	if err != nil {
+		return err
+	}
+	setCurrentTheme(t)
+	return nil
+}
+
+func decodeTheme(name string, data []byte) (Theme, error) {
+	t := DefaultTheme()
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &t); err != nil {
+			return Theme{}, fmt.Errorf("pintui: parse theme %q: %w", name, err)
+		}
+	default:
+		if err := json.Unmarshal(data, &t); err != nil {
+			return Theme{}, fmt.Errorf("pintui: parse theme %q: %w", name, err)
+		}
+	}
+	return t, nil
+}
+
+// colorByName maps an ANSI color name, "faint", or a "#rrggbb" hex value to
+// a color.Sprintf-style function. An empty string, unknown names, and
+// malformed hex values fall back to the plain, uncolored formatter.
+func colorByName(name string) func(string, ...any) string {
+	switch strings.ToLower(name) {
+	case "black":
+		return color.BlackString
+	case "red":
+		return color.RedString
+	case "green":
+		return color.GreenString
+	case "yellow":
+		return color.YellowString
+	case "blue":
+		return color.BlueString
+	case "magenta":
+		return color.MagentaString
+	case "cyan":
+		return color.CyanString
+	case "white":
+		return color.WhiteString
+	case "faint":
+		faint := color.New(color.Faint)
+		return faint.Sprintf
+	case "":
+		return fmt.Sprintf
+	default:
+		if strings.HasPrefix(name, "#") {
+			if c, ok := hexColor(name); ok {
+				return c.Sprintf
+			}
+		}
+		return fmt.Sprintf
+	}
+}
+
+// colorAttr maps an ANSI color name to the color.Attribute used to build a
+// custom *color.Color, mirroring colorByName's name mapping.
+func colorAttr(name string) (color.Attribute, bool) {
+	switch strings.ToLower(name) {
+	case "black":
+		return color.FgBlack, true
+	case "red":
+		return color.FgRed, true
+	case "green":
+		return color.FgGreen, true
+	case "yellow":
+		return color.FgYellow, true
+	case "blue":
+		return color.FgBlue, true
+	case "magenta":
+		return color.FgMagenta, true
+	case "cyan":
+		return color.FgCyan, true
+	case "white":
+		return color.FgWhite, true
+	case "faint":
+		return color.Faint, true
+	default:
+		return 0, false
+	}
+}
+
+// themeStyle returns a Sprint-style formatter for a Theme color name, with
+// extra attributes (e.g. color.Bold) layered on top. An empty name, as
+// MonochromeTheme sets for Colors.Header/Colors.Dim, renders with no ANSI
+// escapes at all and ignores extra attributes, the same way colorByName's
+// "" case keeps icon() escape-free.
+func themeStyle(name string, extra ...color.Attribute) func(a ...any) string {
+	if name == "" {
+		return fmt.Sprint
+	}
+	attrs := append([]color.Attribute(nil), extra...)
+	if attr, ok := colorAttr(name); ok {
+		return color.New(append(attrs, attr)...).Sprint
+	}
+	if strings.HasPrefix(name, "#") {
+		if c, ok := hexColor(name); ok {
+			c.Add(attrs...)
+			return c.Sprint
+		}
+	}
+	return color.New(attrs...).Sprint
+}
+
+func hexColor(hex string) (*color.Color, bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return nil, false
+	}
+	var r, g, b int
+	if _, err := fmt.Sscanf(hex, "%02x%02x%02x", &r, &g, &b); err != nil {
+		return nil, false
+	}
+	return color.RGB(r, g, b), true
+}
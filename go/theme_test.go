@@ -0,0 +1,73 @@
+package pintui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func resetTheme() {
+	setCurrentTheme(DefaultTheme())
+}
+
+func TestDefaultAndMonochromeTheme(t *testing.T) {
+	def := DefaultTheme()
+	if def.Icons.Success != "✓" || def.Colors.Success != "green" {
+		t.Errorf("DefaultTheme() = %+v, want success icon ✓ / color green", def)
+	}
+
+	mono := MonochromeTheme()
+	if mono.Icons.Success != def.Icons.Success {
+		t.Errorf("MonochromeTheme() changed icons, want glyphs unchanged")
+	}
+	if mono.Colors.Success != "" {
+		t.Errorf("MonochromeTheme().Colors.Success = %q, want empty", mono.Colors.Success)
+	}
+}
+
+func TestLoadThemeJSON(t *testing.T) {
+	defer resetTheme()
+
+	path := filepath.Join(t.TempDir(), "theme.json")
+	const tokens = `{
+		"name": "custom",
+		"icons": {"info": "i", "success": "y", "warn": "w", "error": "e"},
+		"colors": {"success": "magenta"},
+		"divider": "=",
+		"indentWidth": 4
+	}`
+	if err := os.WriteFile(path, []byte(tokens), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if err := LoadTheme(path); err != nil {
+		t.Fatalf("LoadTheme: %v", err)
+	}
+
+	got := currentTheme()
+	if got.Icons.Success != "y" || got.Divider != "=" || got.IndentWidth != 4 {
+		t.Errorf("currentTheme() = %+v, want icons.success=y divider== indentWidth=4", got)
+	}
+}
+
+func TestLoadThemeMissingFile(t *testing.T) {
+	if err := LoadTheme(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("LoadTheme(missing file) = nil error, want non-nil")
+	}
+}
+
+func TestRegisterThemeViaEnv(t *testing.T) {
+	defer resetTheme()
+
+	t.Setenv("PINTUI_THEME", "test-registered")
+	RegisterTheme("test-registered", MonochromeTheme())
+	themeState.mu.Lock()
+	registered, ok := themeState.named["test-registered"]
+	themeState.mu.Unlock()
+	if !ok || registered.Name != "monochrome" {
+		t.Errorf("RegisterTheme did not register the theme under test-registered")
+	}
+	if got := currentTheme().Name; got != "monochrome" {
+		t.Errorf("currentTheme().Name = %q after registering the PINTUI_THEME theme, want monochrome", got)
+	}
+}
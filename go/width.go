@@ -0,0 +1,62 @@
+package pintui
+
+import (
+	"regexp"
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// ansiSGR matches ANSI SGR ("\x1b[...m") color/style escape sequences so
+// they can be stripped before measuring display width.
+var ansiSGR = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// displayWidth returns how many terminal columns s occupies: East Asian
+// Wide and Fullwidth runes count as 2, everything else (Narrow, Halfwidth,
+// Neutral, Ambiguous) counts as 1. ANSI SGR escape sequences are stripped
+// first, and zero-width runes (combining marks, ZWJ, variation selectors)
+// contribute 0, so colored or emoji-laden strings measure the same as
+// what a terminal actually renders.
+func displayWidth(s string) int {
+	s = ansiSGR.ReplaceAllString(s, "")
+
+	w := 0
+	for _, r := range s {
+		switch {
+		case isZeroWidth(r):
+			// contributes nothing
+		case isWideRune(r):
+			w += 2
+		default:
+			w++
+		}
+	}
+	return w
+}
+
+// isZeroWidth reports whether r is a combining mark, zero-width joiner, or
+// variation selector that a terminal renders with no additional column.
+func isZeroWidth(r rune) bool {
+	if unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) {
+		return true
+	}
+	switch {
+	case r == 0x200D: // ZERO WIDTH JOINER
+		return true
+	case r >= 0xFE00 && r <= 0xFE0F: // variation selectors
+		return true
+	case r == 0xFEFF: // zero width no-break space / BOM
+		return true
+	}
+	return false
+}
+
+// isWideRune reports whether r is East Asian Wide or Fullwidth.
+func isWideRune(r rune) bool {
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return true
+	default:
+		return false
+	}
+}
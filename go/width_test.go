@@ -0,0 +1,29 @@
+package pintui
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	decomposedE := "e" + string(rune(0x0301)) // "e" + combining acute accent
+
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{"ascii", "hello", 5},
+		{"empty", "", 0},
+		{"cjk", "日本語", 6},
+		{"mixed ascii and cjk", "abc日本語", 9},
+		{"combining mark collapses", decomposedE, 1},
+		{"ansi escapes stripped", "\x1b[1;32mOK\x1b[0m", 2},
+		{"ansi escapes stripped around cjk", "\x1b[36m日本\x1b[0m", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := displayWidth(tt.s); got != tt.want {
+				t.Errorf("displayWidth(%q) = %d, want %d", tt.s, got, tt.want)
+			}
+		})
+	}
+}